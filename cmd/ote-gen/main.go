@@ -0,0 +1,272 @@
+// Command ote-gen generates the Use* wrappers of package ote.
+//
+//	go run ./cmd/ote-gen -args 0-9 -results 0-9 -variants Use,UseErr,UseOption,UseOptionErr -o use.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// allVariants of wrappers for each arity
+var allVariants = []string{"Use", "UseErr", "UseOption", "UseOptionErr"}
+
+// genConfig of generation
+type genConfig struct {
+	Package  string
+	Args     [2]int //min and max count of arguments
+	Results  [2]int //min and max count of results besides error
+	Variants []string
+}
+
+// defaultConfig of use.go
+func defaultConfig() genConfig {
+	return genConfig{Package: "ote", Args: [2]int{0, 9}, Results: [2]int{0, 9}, Variants: allVariants}
+}
+
+func main() {
+	c := defaultConfig()
+	var args, results, variants, out string
+	flag.StringVar(&c.Package, "pkg", c.Package, "package name")
+	flag.StringVar(&args, "args", "0-9", "arity range of arguments, like 0-9 or 3")
+	flag.StringVar(&results, "results", "0-9", "arity range of results besides error, like 0-9 or 3")
+	flag.StringVar(&variants, "variants", strings.Join(allVariants, ","), "variants to generate")
+	flag.StringVar(&out, "o", "use.go", "output file, - for stdout")
+	flag.Parse()
+	var err error
+	if c.Args, err = parseRange(args); err != nil {
+		log.Fatalf("args: %v", err)
+	}
+	if c.Results, err = parseRange(results); err != nil {
+		log.Fatalf("results: %v", err)
+	}
+	c.Variants = strings.Split(variants, ",")
+	src, err := generate(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if out == "-" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(out, src, 0644)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseRange of n or min-max
+func parseRange(s string) (r [2]int, err error) {
+	lo, hi, ok := strings.Cut(s, "-")
+	if r[0], err = strconv.Atoi(lo); err != nil {
+		return
+	}
+	r[1] = r[0]
+	if ok {
+		if r[1], err = strconv.Atoi(hi); err != nil {
+			return
+		}
+	}
+	if r[0] < 0 || r[1] < r[0] || r[1] > 9 {
+		err = fmt.Errorf("invalid range %q, should within 0-9", s)
+	}
+	return
+}
+
+// generate formatted source of wrappers
+func generate(c genConfig) ([]byte, error) {
+	var fns []wrapper
+	var errs, attrs bool
+	for _, v := range c.Variants {
+		switch v {
+		case "Use", "UseOption":
+		case "UseErr", "UseOptionErr":
+			errs = true
+		default:
+			return nil, fmt.Errorf("unknown variant %q, should one of %s", v, strings.Join(allVariants, ","))
+		}
+	}
+	for args := c.Args[0]; args <= c.Args[1]; args++ {
+		attrs = attrs || args > 0
+		for ret := c.Results[0]; ret <= c.Results[1]; ret++ {
+			for _, v := range c.Variants {
+				fns = append(fns, newWrapper(v, args, ret))
+			}
+		}
+	}
+	buf := new(bytes.Buffer)
+	err := file.Execute(buf, map[string]any{"Package": c.Package, "Errors": errs, "Attribute": attrs, "Wrappers": fns})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// wrapper of a variant and arity
+type wrapper struct {
+	Name       string
+	Doc        string
+	TypeParams string
+	Func       string //type of fn and the returned func
+	Params     string //telemetry and span providers
+	Closure    string //parameters of the returned func
+	Results    string //named results of the returned func
+	Span       string //expression of span name and attributes
+	Call       string //call of fn with context %s
+	Values     string //results as []any
+	Option     bool
+	Err        bool
+}
+
+func newWrapper(variant string, args, ret int) wrapper {
+	w := wrapper{
+		Name:   fmt.Sprintf("%s%d%d", variant, args, ret),
+		Option: strings.HasPrefix(variant, "UseOption"),
+		Err:    strings.HasSuffix(variant, "Err"),
+		Doc:    "add span when context not nil",
+	}
+	if w.Option {
+		w.Doc = "add span when context contains Telemetry"
+	}
+	var types, in, names, out, results []string
+	for i := 1; i <= args; i++ {
+		types = append(types, fmt.Sprintf("A%d", i))
+		in = append(in, fmt.Sprintf("a%d A%d", i, i))
+		names = append(names, fmt.Sprintf("a%d", i))
+	}
+	argTypes := types
+	for i := 1; i <= ret; i++ {
+		types = append(types, fmt.Sprintf("R%d", i))
+		out = append(out, fmt.Sprintf("R%d", i))
+		results = append(results, fmt.Sprintf("r%d R%d", i, i))
+	}
+	if len(types) > 0 {
+		w.TypeParams = "[" + strings.Join(types, ", ") + " any]"
+	}
+	if w.Err {
+		out = append(out, "error")
+		results = append(results, "err error")
+	}
+	w.Func = "func(" + strings.Join(append([]string{"context.Context"}, argTypes...), ", ") + ")" + tuple(out)
+	if len(results) > 0 {
+		w.Results = " (" + strings.Join(results, ", ") + ")"
+	}
+	w.Closure = strings.Join(append([]string{"ctx context.Context"}, in...), ", ")
+	if args == 0 {
+		w.Params = "sp SpanProviderFn"
+		w.Span = "sp.span()"
+	} else {
+		w.Params = "sp func(" + strings.Join(argTypes, ", ") + ") (string, []attribute.KeyValue)"
+		w.Span = "sp(" + strings.Join(names, ", ") + ")"
+	}
+	if !w.Option {
+		w.Params = "pp TelemetryProviderFn, " + w.Params
+	}
+	w.Call = "fn(" + strings.Join(append([]string{"%[1]s"}, names...), ", ") + ")"
+	var assign []string
+	for i := 1; i <= ret; i++ {
+		assign = append(assign, fmt.Sprintf("r%d", i))
+	}
+	w.Values = "nil"
+	if len(assign) > 0 {
+		w.Values = "[]any{" + strings.Join(assign, ", ") + "}"
+	}
+	if w.Err {
+		assign = append(assign, "err")
+	}
+	if len(assign) > 0 {
+		w.Call = strings.Join(assign, ", ") + " = " + w.Call
+	}
+	return w
+}
+
+// tuple of result types
+func tuple(types []string) string {
+	switch len(types) {
+	case 0:
+		return ""
+	case 1:
+		return " " + types[0]
+	default:
+		return " (" + strings.Join(types, ", ") + ")"
+	}
+}
+
+var file = template.Must(template.New("file").Funcs(template.FuncMap{
+	"call": func(format, ctx string) string { return fmt.Sprintf(format, ctx) },
+}).Parse(`// Code generated by ote-gen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+{{- if .Errors}}
+	"errors"
+	"fmt"
+{{- end}}
+{{- if .Attribute}}
+
+	"go.opentelemetry.io/otel/attribute"
+{{- end}}
+)
+{{range .Wrappers}}
+// {{.Name}} {{.Doc}}
+func {{.Name}}{{.TypeParams}}(fn {{.Func}}, {{.Params}}, opts ...WrapOption) {{.Func}} {
+	w := newWrapConfig(opts)
+	return func({{.Closure}}){{.Results}} {
+{{- if .Option}}
+		if t := FromContext(ctx); t != nil {
+			n, a := {{.Span}}
+			c, cx := w.start(t, ctx, n, a)
+{{- else}}
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := {{.Span}}
+			c, cx := w.start(t, cx, n, a)
+{{- end}}
+			defer func() {
+{{- if .Err}}
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
+					switch x := r.(type) {
+					case error:
+						err = x
+					case string:
+						err = errors.New(x)
+					default:
+						err = fmt.Errorf("%v", x)
+					}
+				} else if c.result != nil {
+					c.results({{.Values}}, err)
+				}
+				c.end(err, rec)
+{{- else}}
+				r, ok := t.HandleRecover(recover())
+{{- if ne .Values "nil"}}
+				if !ok && c.result != nil {
+					c.results({{.Values}}, nil)
+				}
+{{- end}}
+				c.end(nil, r)
+				if ok {
+					panic(r)
+				}
+{{- end}}
+			}()
+			{{call .Call "cx"}}
+		} else {
+			{{call .Call "ctx"}}
+		}
+{{- if .Results}}
+		return
+{{- end}}
+	}
+}
+{{end}}`))
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestStale fails when the checked-in use.go differs from the generated, run go generate to fix
+func TestStale(t *testing.T) {
+	src, err := generate(defaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	old, err := os.ReadFile("../../use.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(src, old) {
+		t.Fatal("use.go is stale, run go generate in github.com/ZenLiuCN/ote")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	src, err := generate(genConfig{Package: "x", Args: [2]int{0, 0}, Results: [2]int{1, 1}, Variants: []string{"Use"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(src)
+	switch {
+	case strings.Contains(s, `"errors"`) || strings.Contains(s, "attribute"):
+		t.Fatalf("unused imports:\n%s", s)
+	case !strings.Contains(s, "func Use01[R1 any](fn func(context.Context) R1, pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) R1 {"):
+		t.Fatalf("signature:\n%s", s)
+	case strings.Contains(s, "UseErr"):
+		t.Fatalf("variants:\n%s", s)
+	}
+	if _, err = generate(genConfig{Variants: []string{"Bad"}}); err == nil {
+		t.Fatal("expect unknown variant")
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	for s, want := range map[string][2]int{"3": {3, 3}, "0-9": {0, 9}, "2-5": {2, 5}} {
+		if r, err := parseRange(s); err != nil || r != want {
+			t.Fatalf("%s: %v %v", s, r, err)
+		}
+	}
+	for _, s := range []string{"", "a", "5-2", "0-10", "-1"} {
+		if _, err := parseRange(s); err == nil {
+			t.Fatalf("%s: expect error", s)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Document declarative telemetry configuration, follows the shape of the OpenTelemetry
+// configuration file schema, supported subset is what otlp.TraceConfig can express.
+//
+//	file_format: "0.1"
+//	resource:
+//	  attributes:
+//	    service.name: ${SERVICE_NAME:-demo}
+//	propagator:
+//	  composite: [tracecontext, baggage]
+//	tracer_provider:
+//	  processors:
+//	    - batch:
+//	        schedule_delay: 5s
+//	        exporter:
+//	          otlp:
+//	            protocol: http/protobuf
+//	            endpoint: http://localhost:4318
+//	  sampler:
+//	    parent_based:
+//	      root:
+//	        trace_id_ratio_based:
+//	          ratio: 0.1
+type Document struct {
+	FileFormat     string          `json:"file_format" yaml:"file_format"`
+	Disabled       *bool           `json:"disabled" yaml:"disabled"`
+	Strict         bool            `json:"strict" yaml:"strict"` //refuse to setup on invalid config
+	Resource       *Resource       `json:"resource" yaml:"resource"`
+	Propagator     *Propagator     `json:"propagator" yaml:"propagator"`
+	TracerProvider *TracerProvider `json:"tracer_provider" yaml:"tracer_provider"`
+	MeterProvider  *MeterProvider  `json:"meter_provider" yaml:"meter_provider"`
+	LoggerProvider *LoggerProvider `json:"logger_provider" yaml:"logger_provider"`
+}
+
+type Resource struct {
+	Attributes map[string]string `json:"attributes" yaml:"attributes"` //only service.name supported
+	Detectors  *Detectors        `json:"detectors" yaml:"detectors"`
+}
+
+// Detectors switches of resource detectors, all enabled by default
+type Detectors struct {
+	Container *bool `json:"container" yaml:"container"`
+	Host      *bool `json:"host" yaml:"host"`
+	HostId    *bool `json:"host_id" yaml:"host_id"`
+	Env       *bool `json:"env" yaml:"env"`
+	Process   *bool `json:"process" yaml:"process"`
+	SDK       *bool `json:"sdk" yaml:"sdk"`
+}
+
+type Propagator struct {
+	Composite []string `json:"composite" yaml:"composite"`
+}
+
+type TracerProvider struct {
+	Processors []SpanProcessor `json:"processors" yaml:"processors"`
+	Sampler    *Sampler        `json:"sampler" yaml:"sampler"`
+}
+
+type SpanProcessor struct {
+	Batch *BatchSpanProcessor `json:"batch" yaml:"batch"`
+}
+
+type BatchSpanProcessor struct {
+	ScheduleDelay      *Duration `json:"schedule_delay" yaml:"schedule_delay"`
+	ExportTimeout      *Duration `json:"export_timeout" yaml:"export_timeout"`
+	MaxQueueSize       *int32    `json:"max_queue_size" yaml:"max_queue_size"`
+	MaxExportBatchSize *int32    `json:"max_export_batch_size" yaml:"max_export_batch_size"`
+	Blocking           *bool     `json:"blocking" yaml:"blocking"`
+	Exporter           Exporter  `json:"exporter" yaml:"exporter"`
+}
+
+type Exporter struct {
+	OTLP *OTLP `json:"otlp" yaml:"otlp"`
+}
+
+type OTLP struct {
+	Protocol                    string            `json:"protocol" yaml:"protocol"`
+	Endpoint                    string            `json:"endpoint" yaml:"endpoint"`
+	Headers                     map[string]string `json:"headers" yaml:"headers"`
+	Compression                 string            `json:"compression" yaml:"compression"`
+	Timeout                     *Duration         `json:"timeout" yaml:"timeout"`
+	Insecure                    *bool             `json:"insecure" yaml:"insecure"`
+	Reconnect                   *Duration         `json:"reconnect" yaml:"reconnect"`
+	Retry                       *Retry            `json:"retry" yaml:"retry"`
+	TemporalityPreference       string            `json:"temporality_preference" yaml:"temporality_preference"`
+	DefaultHistogramAggregation string            `json:"default_histogram_aggregation" yaml:"default_histogram_aggregation"`
+}
+
+type Retry struct {
+	Enabled         bool      `json:"enabled" yaml:"enabled"`
+	InitialInterval *Duration `json:"initial_interval" yaml:"initial_interval"`
+	MaxInterval     *Duration `json:"max_interval" yaml:"max_interval"`
+	MaxElapsedTime  *Duration `json:"max_elapsed_time" yaml:"max_elapsed_time"`
+}
+
+type MeterProvider struct {
+	Readers []MetricReader `json:"readers" yaml:"readers"`
+}
+
+type MetricReader struct {
+	Periodic *PeriodicReader `json:"periodic" yaml:"periodic"`
+	Pull     *PullReader     `json:"pull" yaml:"pull"`
+}
+
+type PeriodicReader struct {
+	Interval *Duration `json:"interval" yaml:"interval"`
+	Timeout  *Duration `json:"timeout" yaml:"timeout"`
+	Exporter Exporter  `json:"exporter" yaml:"exporter"`
+}
+
+// PullReader only prometheus exporter supported, which is always installed
+type PullReader struct {
+	Exporter map[string]any `json:"exporter" yaml:"exporter"`
+}
+
+type LoggerProvider struct {
+	Processors []LogProcessor `json:"processors" yaml:"processors"`
+}
+
+type LogProcessor struct {
+	Batch *BatchLogProcessor `json:"batch" yaml:"batch"`
+}
+
+type BatchLogProcessor struct {
+	ScheduleDelay      *Duration `json:"schedule_delay" yaml:"schedule_delay"`
+	ExportTimeout      *Duration `json:"export_timeout" yaml:"export_timeout"`
+	MaxQueueSize       *int32    `json:"max_queue_size" yaml:"max_queue_size"`
+	MaxExportBatchSize *int32    `json:"max_export_batch_size" yaml:"max_export_batch_size"`
+	Exporter           Exporter  `json:"exporter" yaml:"exporter"`
+}
+
+// Load read Document from a yaml or json (by .json extension) file
+func Load(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	format := "yaml"
+	if filepath.Ext(path) == ".json" {
+		format = "json"
+	}
+	doc, err := Parse(data, format)
+	if err != nil {
+		return nil, fmt.Errorf("telemetry.config %s: %w", path, err)
+	}
+	return doc, nil
+}
+
+// Parse Document of format json or yaml, environment references in form of ${NAME} or ${NAME:-default} are substituted first
+func Parse(data []byte, format string) (doc *Document, err error) {
+	data = expandEnv(data)
+	doc = new(Document)
+	switch format {
+	case "json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(doc)
+	case "yaml", "yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(doc)
+	default:
+		return nil, fmt.Errorf("telemetry.config format not one of json|yaml: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+var envRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?}`)
+
+func expandEnv(data []byte) []byte {
+	return envRef.ReplaceAllFunc(data, func(ref []byte) []byte {
+		m := envRef.FindSubmatch(ref)
+		if v, ok := os.LookupEnv(string(m[1])); ok && v != "" {
+			return []byte(v)
+		}
+		return m[3]
+	})
+}
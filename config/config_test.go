@@ -0,0 +1,209 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const yamlDoc = `
+file_format: "0.1"
+resource:
+  attributes:
+    service.name: ${OTE_TEST_SERVICE:-fallback}
+  detectors:
+    host_id: false
+propagator:
+  composite: [tracecontext, baggage]
+tracer_provider:
+  processors:
+    - batch:
+        schedule_delay: 500
+        export_timeout: 30s
+        max_queue_size: 1024
+        exporter:
+          otlp:
+            protocol: http/protobuf
+            endpoint: http://localhost:4318
+            headers:
+              api-key: ${OTE_TEST_KEY}
+            compression: gzip
+            timeout: 1m
+            insecure: true
+  sampler:
+    parent_based:
+      root:
+        trace_id_ratio_based:
+          ratio: 0.5
+      remote_parent_sampled:
+        trace_id_ratio_based:
+          ratio: 0.5
+meter_provider:
+  readers:
+    - pull:
+        exporter:
+          prometheus:
+    - periodic:
+        interval: 10s
+        exporter:
+          otlp:
+            endpoint: http://localhost:4317
+            temporality_preference: delta
+logger_provider:
+  processors:
+    - batch:
+        exporter:
+          otlp:
+            protocol: http/protobuf
+`
+
+func TestParseYaml(t *testing.T) {
+	t.Setenv("OTE_TEST_KEY", "secret")
+	doc, err := Parse([]byte(yamlDoc), "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := doc.TraceConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch {
+	case c.Service.String != "fallback" || !c.HostId.Valid || c.HostId.Bool || c.Host.Valid:
+		t.Fatalf("resource %+v", c.Config)
+	case c.ExportBatchTimeout != 500*time.Millisecond || c.ExportTimeout != 30*time.Second || c.QueueSize.Int32 != 1024:
+		t.Fatalf("batch %v %v %v", c.ExportBatchTimeout, c.ExportTimeout, c.QueueSize)
+	case c.Protocol != "http/protobuf" || c.Headers["api-key"] != "secret" || c.Timeout != time.Minute || !c.Insecure.Bool:
+		t.Fatalf("exporter %+v", c)
+	case c.Sampler.Name != "parent" || c.Sampler.Based != "ratio" || c.Sampler.Ratio.Float64 != 0.5 || c.Sampler.Options[0] != "withRemote":
+		t.Fatalf("sampler %+v", c.Sampler)
+	case c.Metric == nil || c.Metric.Interval != 10*time.Second || c.Metric.Temporality != "delta":
+		t.Fatalf("metric %+v", c.Metric)
+	case c.Log == nil || c.Log.Protocol != "http/protobuf":
+		t.Fatalf("log %+v", c.Log)
+	}
+}
+
+func TestParseJson(t *testing.T) {
+	doc, err := Parse([]byte(`{
+		"disabled": true,
+		"tracer_provider": {
+			"processors": [{"batch": {"schedule_delay": "2s", "exporter": {"otlp": {"endpoint": "http://localhost:4317"}}}}],
+			"sampler": {"always_on": null}
+		}
+	}`), "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := doc.TraceConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Disabled.Bool || c.ExportBatchTimeout != 2*time.Second || c.Sampler.Name != "always" {
+		t.Fatalf("config %+v", c)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	if _, err := Parse([]byte("tracer_provider:\n  sampler_typo: {}\n"), "yaml"); err == nil {
+		t.Fatal("expect unknown field error")
+	}
+	if _, err := Parse([]byte(`{"tracer_provider":{"processors":[{"batch":{"schedule_delay":"soon"}}]}}`), "json"); err == nil {
+		t.Fatal("expect duration error")
+	}
+	doc, err := Parse([]byte(`
+tracer_provider:
+  processors:
+    - batch: {}
+  sampler:
+    parent_based:
+      root:
+        always_on:
+      local_parent_sampled:
+        always_off:
+`), "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = doc.TraceConfig(); err == nil {
+		t.Fatal("expect conversion errors")
+	}
+}
+
+func TestParseRuleBased(t *testing.T) {
+	doc, err := Parse([]byte(`
+resource:
+  attributes:
+    service.name: demo
+tracer_provider:
+  processors:
+    - batch:
+        exporter:
+          otlp:
+            endpoint: http://localhost:4317
+  sampler:
+    parent_based:
+      root:
+        rule_based:
+          rules:
+            - attributes:
+                http.route: /healthz
+              sampler:
+                always_off:
+            - name: "GET *"
+              kind: server
+              sampler:
+                trace_id_ratio_based:
+                  ratio: 0.2
+          default:
+            always_on:
+`), "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := doc.TraceConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := c.Sampler
+	switch {
+	case s.Name != "parent" || s.Based != "rules" || len(s.Rules) != 2:
+		t.Fatalf("sampler %+v", s)
+	case s.Rules[0].Attributes["http.route"] != "/healthz" || s.Rules[0].Sampler.Name != "never":
+		t.Fatalf("rule %+v", s.Rules[0])
+	case s.Rules[1].Kind != "server" || s.Rules[1].Sampler.Ratio.Float64 != 0.2:
+		t.Fatalf("rule %+v", s.Rules[1])
+	case s.Default == nil || s.Default.Name != "always":
+		t.Fatalf("default %+v", s.Default)
+	}
+	if err = c.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseNestedUnknownField(t *testing.T) {
+	for format, src := range map[string]string{
+		"yaml": `
+tracer_provider:
+  sampler:
+    parent_based:
+      root:
+        trace_id_ratio_based:
+          ration: 0.1
+`,
+		"json": `{"tracer_provider":{"sampler":{"parent_based":{"root":{"trace_id_ratio_based":{"ration":0.1}}}}}}`,
+	} {
+		if _, err := Parse([]byte(src), format); err == nil || !strings.Contains(err.Error(), "ration") {
+			t.Fatalf("%s: expect unknown field error, got %v", format, err)
+		}
+	}
+	//!! rules inside nested blocks are strict too
+	if _, err := Parse([]byte(`
+tracer_provider:
+  sampler:
+    rule_based:
+      rules:
+        - nmae: "GET *"
+`), "yaml"); err == nil {
+		t.Fatal("expect unknown rule field error")
+	}
+}
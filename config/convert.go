@@ -0,0 +1,248 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ZenLiuCN/ote/otlp"
+	"github.com/ZenLiuCN/ote/resource"
+	otlpgrpc "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+)
+
+// TraceConfig convert Document to otlp.TraceConfig used by SetupTelemetry
+func (d *Document) TraceConfig() (*otlp.TraceConfig, error) {
+	c := &otlp.TraceConfig{
+		Disabled: nullBool(d.Disabled),
+		Strict:   d.Strict,
+		Config:   new(resource.Config),
+	}
+	var errs []error
+	fail := func(path string, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+	}
+	//!! resource
+	if r := d.Resource; r != nil {
+		for k, v := range r.Attributes {
+			switch k {
+			case "service.name":
+				c.Service.String, c.Service.Valid = v, true
+			default:
+				fail("resource.attributes."+k, "unsupported attribute")
+			}
+		}
+		if x := r.Detectors; x != nil {
+			c.Container = nullBool(x.Container)
+			c.Host = nullBool(x.Host)
+			c.HostId = nullBool(x.HostId)
+			c.Env = nullBool(x.Env)
+			c.Process = nullBool(x.Process)
+			c.SDK = nullBool(x.SDK)
+		}
+	}
+	//!! propagator
+	if p := d.Propagator; p != nil {
+		c.Propagators = p.Composite
+	}
+	//!! trace
+	if t := d.TracerProvider; t != nil {
+		switch {
+		case len(t.Processors) > 1:
+			fail("tracer_provider.processors", "only one processor supported")
+		case len(t.Processors) == 1 && t.Processors[0].Batch == nil:
+			fail("tracer_provider.processors[0]", "only batch processor supported")
+		case len(t.Processors) == 1:
+			b := t.Processors[0].Batch
+			c.ExportBatchTimeout = b.ScheduleDelay.Value()
+			c.ExportTimeout = b.ExportTimeout.Value()
+			c.QueueSize = nullInt32(b.MaxQueueSize)
+			c.ExportBatchSize = nullInt32(b.MaxExportBatchSize)
+			c.QueueBlocking = nullBool(b.Blocking)
+			if o := b.Exporter.OTLP; o == nil {
+				fail("tracer_provider.processors[0].batch.exporter", "only otlp exporter supported")
+			} else {
+				c.Protocol = o.Protocol
+				c.Endpoint = o.Endpoint
+				c.Headers = o.Headers
+				c.Compress = o.Compression
+				c.Timeout = o.Timeout.Value()
+				c.Insecure = nullBool(o.Insecure)
+				c.Reconnect = o.Reconnect.Value()
+				c.Retry = o.Retry.config()
+			}
+		}
+		if s := t.Sampler; s != nil {
+			var err error
+			if c.Sampler, err = s.config(); err != nil {
+				fail("tracer_provider.sampler", "%s", err)
+			}
+		}
+	}
+	//!! metric
+	if m := d.MeterProvider; m != nil {
+		for i, r := range m.Readers {
+			path := fmt.Sprintf("meter_provider.readers[%d]", i)
+			switch {
+			case r.Periodic != nil && r.Pull != nil:
+				fail(path, "reader must be one of periodic|pull")
+			case r.Pull != nil:
+				for k := range r.Pull.Exporter {
+					if k != "prometheus" {
+						fail(path+".pull.exporter", "only prometheus exporter supported: %s", k)
+					}
+				}
+			case r.Periodic != nil && c.Metric != nil:
+				fail(path, "only one periodic reader supported")
+			case r.Periodic != nil:
+				p := r.Periodic
+				c.Metric = &otlp.MetricConfig{
+					Interval:      p.Interval.Value(),
+					ExportTimeout: p.Timeout.Value(),
+				}
+				if o := p.Exporter.OTLP; o == nil {
+					fail(path+".periodic.exporter", "only otlp exporter supported")
+				} else {
+					c.Metric.Protocol = o.Protocol
+					c.Metric.Endpoint = o.Endpoint
+					c.Metric.Headers = o.Headers
+					c.Metric.Compress = o.Compression
+					c.Metric.Timeout = o.Timeout.Value()
+					c.Metric.Insecure = nullBool(o.Insecure)
+					c.Metric.Reconnect = o.Reconnect.Value()
+					c.Metric.Retry = o.Retry.config()
+					c.Metric.Temporality = o.TemporalityPreference
+					c.Metric.Aggregation = o.DefaultHistogramAggregation
+				}
+			default:
+				fail(path, "reader must be one of periodic|pull")
+			}
+		}
+	}
+	//!! log
+	if l := d.LoggerProvider; l != nil {
+		switch {
+		case len(l.Processors) > 1:
+			fail("logger_provider.processors", "only one processor supported")
+		case len(l.Processors) == 1 && l.Processors[0].Batch == nil:
+			fail("logger_provider.processors[0]", "only batch processor supported")
+		case len(l.Processors) == 1:
+			b := l.Processors[0].Batch
+			c.Log = &otlp.LogConfig{
+				ExportInterval: b.ScheduleDelay.Value(),
+				ExportTimeout:  b.ExportTimeout.Value(),
+				QueueSize:      nullInt32(b.MaxQueueSize),
+				ExportMaxBatch: nullInt32(b.MaxExportBatchSize),
+			}
+			if o := b.Exporter.OTLP; o == nil {
+				fail("logger_provider.processors[0].batch.exporter", "only otlp exporter supported")
+			} else {
+				c.Log.Protocol = o.Protocol
+				c.Log.Endpoint = o.Endpoint
+				c.Log.Headers = o.Headers
+				c.Log.Compress = o.Compression
+				c.Log.Timeout = o.Timeout.Value()
+				c.Log.Insecure = nullBool(o.Insecure)
+				c.Log.Reconnect = o.Reconnect.Value()
+				c.Log.Retry = o.Retry.config()
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return c, nil
+}
+
+func (r *Retry) config() *otlpgrpc.RetryConfig {
+	if r == nil {
+		return nil
+	}
+	return &otlpgrpc.RetryConfig{
+		Enabled:         r.Enabled,
+		InitialInterval: r.InitialInterval.Value(),
+		MaxInterval:     r.MaxInterval.Value(),
+		MaxElapsedTime:  r.MaxElapsedTime.Value(),
+	}
+}
+
+func (s *Sampler) config() (*otlp.SamplerConfig, error) {
+	switch {
+	case s.AlwaysOn != nil:
+		return &otlp.SamplerConfig{Name: "always"}, nil
+	case s.AlwaysOff != nil:
+		return &otlp.SamplerConfig{Name: "never"}, nil
+	case s.TraceIDRatioBased != nil:
+		return &otlp.SamplerConfig{Name: "ratio", Ratio: nullFloat64(s.TraceIDRatioBased.Ratio)}, nil
+	case s.JaegerRemote != nil:
+		c := &otlp.SamplerConfig{Name: "remote", Endpoint: s.JaegerRemote.Endpoint, Interval: s.JaegerRemote.Interval.Value()}
+		if i := s.JaegerRemote.InitialSampler; i != nil {
+			var err error
+			if c.Default, err = i.config(); err != nil {
+				return nil, fmt.Errorf("jaeger_remote.initial_sampler: %w", err)
+			}
+		}
+		return c, nil
+	case s.RateLimiting != nil:
+		return &otlp.SamplerConfig{Name: "ratelimit", Rate: nullFloat64(s.RateLimiting.TracesPerSecond)}, nil
+	case s.ParentBased != nil:
+		p := s.ParentBased
+		if p.Root == nil {
+			return nil, fmt.Errorf("parent_based.root required")
+		}
+		root, err := p.Root.config()
+		if err != nil {
+			return nil, fmt.Errorf("parent_based.root: %w", err)
+		}
+		if root.Name == "parent" {
+			return nil, fmt.Errorf("parent_based.root can not be parent_based")
+		}
+		c := &otlp.SamplerConfig{Name: "parent", Based: root.Name, Ratio: root.Ratio, Rate: root.Rate, Rules: root.Rules, Default: root.Default,
+			Endpoint: root.Endpoint, Interval: root.Interval}
+		for _, o := range []struct {
+			name    string
+			option  string
+			sampler *Sampler
+		}{
+			{"remote_parent_sampled", "withRemote", p.RemoteParentSampled},
+			{"remote_parent_not_sampled", "withoutRemote", p.RemoteParentNotSampled},
+			{"local_parent_sampled", "withLocal", p.LocalParentSampled},
+			{"local_parent_not_sampled", "withoutLocal", p.LocalParentNotSampled},
+		} {
+			if o.sampler == nil {
+				continue
+			}
+			if !reflect.DeepEqual(o.sampler, p.Root) {
+				return nil, fmt.Errorf("parent_based.%s only supports the same sampler as root", o.name)
+			}
+			c.Options = append(c.Options, o.option)
+		}
+		return c, nil
+	case s.RuleBased != nil:
+		c := &otlp.SamplerConfig{Name: "rules"}
+		for i, r := range s.RuleBased.Rules {
+			if r.Sampler == nil {
+				return nil, fmt.Errorf("rule_based.rules[%d].sampler required", i)
+			}
+			sampler, err := r.Sampler.config()
+			if err != nil {
+				return nil, fmt.Errorf("rule_based.rules[%d].sampler: %w", i, err)
+			}
+			c.Rules = append(c.Rules, otlp.SamplerRule{
+				Name:       r.Name,
+				NameRegex:  r.NameRegex,
+				Kind:       r.Kind,
+				Attributes: r.Attributes,
+				Sampler:    sampler,
+			})
+		}
+		if d := s.RuleBased.Default; d != nil {
+			var err error
+			if c.Default, err = d.config(); err != nil {
+				return nil, fmt.Errorf("rule_based.default: %w", err)
+			}
+		}
+		return c, nil
+	default:
+		return nil, fmt.Errorf("sampler kind required")
+	}
+}
@@ -0,0 +1,141 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Sampler one of the sampler kinds, keys with empty value like `always_on:` are accepted
+type Sampler struct {
+	AlwaysOn          *struct{}           `json:"always_on" yaml:"always_on"`
+	AlwaysOff         *struct{}           `json:"always_off" yaml:"always_off"`
+	TraceIDRatioBased *RatioSampler       `json:"trace_id_ratio_based" yaml:"trace_id_ratio_based"`
+	ParentBased       *ParentBasedSampler `json:"parent_based" yaml:"parent_based"`
+	RuleBased         *RuleBasedSampler   `json:"rule_based" yaml:"rule_based"`
+	RateLimiting      *RateLimitSampler   `json:"rate_limiting" yaml:"rate_limiting"`
+	JaegerRemote      *JaegerRemote       `json:"jaeger_remote" yaml:"jaeger_remote"`
+}
+
+type RatioSampler struct {
+	Ratio *float64 `json:"ratio" yaml:"ratio"`
+}
+
+type RateLimitSampler struct {
+	TracesPerSecond *float64 `json:"traces_per_second" yaml:"traces_per_second"`
+}
+
+// JaegerRemote polls sampling strategies from endpoint, initial_sampler decides until the first success
+type JaegerRemote struct {
+	Endpoint       string    `json:"endpoint" yaml:"endpoint"`
+	Interval       *Duration `json:"interval" yaml:"interval"`
+	InitialSampler *Sampler  `json:"initial_sampler" yaml:"initial_sampler"`
+}
+
+// ParentBasedSampler the parent cases only support the same sampler as root
+type ParentBasedSampler struct {
+	Root                   *Sampler `json:"root" yaml:"root"`
+	RemoteParentSampled    *Sampler `json:"remote_parent_sampled" yaml:"remote_parent_sampled"`
+	RemoteParentNotSampled *Sampler `json:"remote_parent_not_sampled" yaml:"remote_parent_not_sampled"`
+	LocalParentSampled     *Sampler `json:"local_parent_sampled" yaml:"local_parent_sampled"`
+	LocalParentNotSampled  *Sampler `json:"local_parent_not_sampled" yaml:"local_parent_not_sampled"`
+}
+
+// RuleBasedSampler the first matched rule decides, otherwise the default (always_on if absent)
+type RuleBasedSampler struct {
+	Rules   []SamplerRule `json:"rules" yaml:"rules"`
+	Default *Sampler      `json:"default" yaml:"default"`
+}
+
+type SamplerRule struct {
+	Name       string            `json:"name" yaml:"name"`
+	NameRegex  string            `json:"name_regex" yaml:"name_regex"`
+	Kind       string            `json:"kind" yaml:"kind"`
+	Attributes map[string]string `json:"attributes" yaml:"attributes"`
+	Sampler    *Sampler          `json:"sampler" yaml:"sampler"`
+}
+
+// decode dispatch sampler kind by key, decoder decodes the value into target
+func (s *Sampler) decode(keys []string, decode func(key string, target any) error) error {
+	if len(keys) != 1 {
+		return fmt.Errorf("sampler must have exactly one kind, got %v", keys)
+	}
+	var target any
+	switch keys[0] {
+	case "always_on":
+		s.AlwaysOn = &struct{}{}
+		return nil
+	case "always_off":
+		s.AlwaysOff = &struct{}{}
+		return nil
+	case "trace_id_ratio_based":
+		s.TraceIDRatioBased = new(RatioSampler)
+		target = s.TraceIDRatioBased
+	case "parent_based":
+		s.ParentBased = new(ParentBasedSampler)
+		target = s.ParentBased
+	case "rule_based":
+		s.RuleBased = new(RuleBasedSampler)
+		target = s.RuleBased
+	case "jaeger_remote":
+		s.JaegerRemote = new(JaegerRemote)
+		target = s.JaegerRemote
+	case "rate_limiting":
+		s.RateLimiting = new(RateLimitSampler)
+		target = s.RateLimiting
+	default:
+		return fmt.Errorf("unknown sampler %s", keys[0])
+	}
+	return decode(keys[0], target)
+}
+
+func (s *Sampler) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return s.decode(keys, func(key string, target any) error {
+		if v := m[key]; len(v) > 0 && string(v) != "null" {
+			//!! keep DisallowUnknownFields of Parse, a typo must not fallback to defaults
+			dec := json.NewDecoder(bytes.NewReader(v))
+			dec.DisallowUnknownFields()
+			return dec.Decode(target)
+		}
+		return nil
+	})
+}
+
+func (s *Sampler) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: sampler must be a mapping", n.Line)
+	}
+	m := make(map[string]*yaml.Node)
+	keys := make([]string, 0, len(n.Content)/2)
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		m[n.Content[i].Value] = n.Content[i+1]
+		keys = append(keys, n.Content[i].Value)
+	}
+	return s.decode(keys, func(key string, target any) error {
+		if v := m[key]; v.Tag != "!!null" {
+			//!! node.Decode ignores KnownFields of Parse, decode again with a strict decoder
+			b, err := yaml.Marshal(v)
+			if err != nil {
+				return err
+			}
+			dec := yaml.NewDecoder(bytes.NewReader(b))
+			dec.KnownFields(true)
+			if err = dec.Decode(target); err != nil {
+				return fmt.Errorf("line %d: %s: %w", v.Line, key, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,154 @@
+package ote
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sem "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var commands = &scoped{scope: "github.com/ZenLiuCN/ote/exec"}
+
+// envCarrier propagation.TextMapCarrier of environment variables, keys like traceparent are stored as TRACEPARENT
+type envCarrier map[string]string
+
+func envKey(key string) string {
+	return strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+}
+
+func (c envCarrier) Get(key string) string {
+	return c[envKey(key)]
+}
+
+func (c envCarrier) Set(key, value string) {
+	c[envKey(key)] = value
+}
+
+func (c envCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// InjectCommand set the context into environment of cmd as TRACEPARENT, TRACESTATE and BAGGAGE
+// (or variables of other configured propagators), nil cmd.Env is filled with os.Environ first.
+func InjectCommand(ctx context.Context, cmd *exec.Cmd) {
+	c := envCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, c)
+	if len(c) == 0 {
+		return
+	}
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	env := cmd.Env[:0:0]
+	for _, kv := range cmd.Env {
+		if k, _, _ := strings.Cut(kv, "="); c[k] == "" {
+			env = append(env, kv)
+		}
+	}
+	for k, v := range c {
+		env = append(env, k+"="+v)
+	}
+	cmd.Env = env
+}
+
+// ContextFromEnv extract the context set by the parent process from environment variables,
+// use as the root context of a child process before ByContext:
+//
+//	tel, ctx := ByContext(ContextFromEnv(context.Background()), nil)
+func ContextFromEnv(ctx context.Context) context.Context {
+	c := envCarrier{}
+	p := otel.GetTextMapPropagator()
+	for _, k := range p.Fields() {
+		if v, ok := os.LookupEnv(envKey(k)); ok {
+			c[envKey(k)] = v
+		}
+	}
+	return p.Extract(ctx, c)
+}
+
+// RunCommand run cmd in a span named by the command path with context injected into cmd environment.
+// Args are recorded through redact, nil redact is DefaultRedactArgs, the exit code is recorded when the command started.
+func RunCommand(ctx context.Context, cmd *exec.Cmd, redact func(args []string) []string) error {
+	t := commands.fromContext(ctx)
+	if t == nil {
+		return cmd.Run()
+	}
+	if redact == nil {
+		redact = DefaultRedactArgs
+	}
+	args := redact(append([]string(nil), cmd.Args...))
+	ctx, sp := t.tracer.Start(ctx, cmd.Path, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		sem.ProcessCommand(cmd.Path),
+		sem.ProcessCommandArgs(args...),
+	))
+	defer sp.End()
+	InjectCommand(ctx, cmd)
+	err := cmd.Run()
+	if cmd.ProcessState != nil {
+		sp.SetAttributes(sem.ProcessExitCode(cmd.ProcessState.ExitCode()))
+	}
+	if err != nil {
+		sp.RecordError(err)
+		sp.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// sensitiveFlags parts of flag names redacted by DefaultRedactArgs
+var sensitiveFlags = []string{"password", "passwd", "pwd", "token", "secret", "key", "credential", "auth"}
+
+// DefaultRedactArgs the redact of RunCommand when nil, replace values of flags which name contains
+// password, passwd, pwd, token, secret, key, credential or auth, like --api-key=x or -client-secret x, with ***.
+// Secrets in positional args are not recognized, use a custom redact for them.
+func DefaultRedactArgs(args []string) []string {
+	return redactArgs(args, func(name string) bool {
+		name = strings.ToLower(name)
+		for _, s := range sensitiveFlags {
+			if strings.Contains(name, s) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// RedactArgs returns a redact func of RunCommand replacing values of flags like -password=x or --token x with ***
+func RedactArgs(flags ...string) func(args []string) []string {
+	return func(args []string) []string {
+		return redactArgs(args, func(name string) bool { return containsFold(flags, name) })
+	}
+}
+
+func redactArgs(args []string, sensitive func(name string) bool) []string {
+	for i := 0; i < len(args); i++ {
+		name, _, hasValue := strings.Cut(strings.TrimLeft(args[i], "-"), "=")
+		if !strings.HasPrefix(args[i], "-") || !sensitive(name) {
+			continue
+		}
+		if hasValue {
+			args[i] = args[i][:strings.IndexByte(args[i], '=')+1] + "***"
+		} else if i+1 < len(args) {
+			i++
+			args[i] = "***"
+		}
+	}
+	return args
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package ote
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TestHelperProcess the child process of TestRunCommand
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("OTE_HELPER_PROCESS") != "1" {
+		return
+	}
+	setupTestTelemetry(t)
+	ctx := ContextFromEnv(context.Background())
+	sc := trace.SpanContextFromContext(ctx)
+	fmt.Printf("%s %s %s", sc.TraceID(), sc.SpanID(), baggage.FromContext(ctx).Member("user").Value())
+	if os.Getenv("OTE_HELPER_EXIT") != "" {
+		os.Exit(3)
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	rec, _ := setupTestTelemetry(t)
+	m, _ := baggage.NewMember("user", "alice")
+	b, _ := baggage.New(m)
+	ctx := baggage.ContextWithBaggage(context.Background(), b)
+	te := NewTelemetry("test")
+	ctx, root := te.StartSpan("root", te.SetContext(ctx))
+	defer root.End()
+
+	var out strings.Builder
+	cmd := exec.Command(os.Args[0], "-test.run=^TestHelperProcess$", "--", "-password=secret", "--token", "t0k")
+	cmd.Env = append(os.Environ(), "OTE_HELPER_PROCESS=1", "TRACEPARENT=stale")
+	cmd.Stdout = &out
+	if err := RunCommand(ctx, cmd, RedactArgs("password", "token")); err != nil {
+		t.Fatal(err, out.String())
+	}
+	sp := rec.Ended()[0]
+	want := fmt.Sprintf("%s %s alice", sp.SpanContext().TraceID(), sp.SpanContext().SpanID())
+	if !strings.HasPrefix(out.String(), want) {
+		t.Fatalf("child context %q want %q", out.String(), want)
+	}
+	args := attr(sp.Attributes(), "process.command_args").AsStringSlice()
+	switch {
+	case sp.Parent().SpanID() != root.SpanContext().SpanID():
+		t.Fatalf("parent %v", sp.Parent())
+	case len(args) != 6 || args[3] != "-password=***" || args[5] != "***":
+		t.Fatalf("args %v", args)
+	case attr(sp.Attributes(), "process.exit.code").AsInt64() != 0:
+		t.Fatalf("attributes %v", sp.Attributes())
+	}
+
+	cmd = exec.Command(os.Args[0], "-test.run=^TestHelperProcess$", "--", "--api-key=k", "-client-secret", "s", "-v")
+	cmd.Env = append(os.Environ(), "OTE_HELPER_PROCESS=1", "OTE_HELPER_EXIT=1")
+	if err := RunCommand(ctx, cmd, nil); err == nil {
+		t.Fatal("expect exit error")
+	}
+	sp = rec.Ended()[1]
+	if attr(sp.Attributes(), "process.exit.code").AsInt64() != 3 || sp.Status().Code != codes.Error {
+		t.Fatalf("failed command %v %v", sp.Attributes(), sp.Status())
+	}
+	//!! secrets are redacted by default
+	if args = attr(sp.Attributes(), "process.command_args").AsStringSlice(); args[3] != "--api-key=***" || args[5] != "***" || args[6] != "-v" {
+		t.Fatalf("default redacted args %v", args)
+	}
+}
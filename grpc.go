@@ -0,0 +1,278 @@
+package ote
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sem "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor server span of each call, remote context is extracted from metadata and
+// the Telemetry of scope is set to the context if absent, as SpanByContext does.
+func UnaryServerInterceptor(scope string) grpc.UnaryServerInterceptor {
+	s := &scoped{scope: scope}
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, sp := s.startServer(ctx, info.FullMethod)
+		if sp == nil {
+			return handler(ctx, req)
+		}
+		defer sp.End()
+		res, err := handler(ctx, req)
+		finishRPC(sp, err, true)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor server span of each stream with message events
+func StreamServerInterceptor(scope string) grpc.StreamServerInterceptor {
+	s := &scoped{scope: scope}
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, sp := s.startServer(ss.Context(), info.FullMethod)
+		if sp == nil {
+			return handler(srv, ss)
+		}
+		defer sp.End()
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx, span: sp})
+		finishRPC(sp, err, true)
+		return err
+	}
+}
+
+// UnaryClientInterceptor client span of each call of the context Telemetry or the Telemetry of scope,
+// the span context is injected into outgoing metadata.
+func UnaryClientInterceptor(scope string) grpc.UnaryClientInterceptor {
+	s := &scoped{scope: scope}
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, sp := s.startClient(ctx, method, cc)
+		if sp == nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		defer sp.End()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		finishRPC(sp, err, false)
+		return err
+	}
+}
+
+// StreamClientInterceptor client span of each stream with message events, ends when the stream finished
+func StreamClientInterceptor(scope string) grpc.StreamClientInterceptor {
+	s := &scoped{scope: scope}
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, sp := s.startClient(ctx, method, cc)
+		if sp == nil {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			finishRPC(sp, err, false)
+			sp.End()
+			return nil, err
+		}
+		w := &clientStream{ClientStream: cs, desc: desc, span: sp}
+		//!! stream context is done when the stream finished, a RecvMsg in progress ends the span with the real status
+		go func() {
+			select {
+			case <-ctx.Done():
+				w.finish(ctx.Err())
+			case <-cs.Context().Done():
+				if w.receiving.Load() == 0 {
+					w.finish(status.FromContextError(cs.Context().Err()).Err())
+				}
+			}
+		}()
+		return w, nil
+	}
+}
+
+func (s *scoped) startServer(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	t := s.telemetry()
+	if t == nil {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = t.propagator().Extract(ctx, metadataCarrier(md))
+	te, ok := FromContext(ctx).(*telemetry)
+	if !ok {
+		te = t
+		ctx = t.SetContext(ctx)
+	}
+	attrs := rpcAttributes(fullMethod)
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, port := hostPort(p.Addr.String())
+		attrs = append(attrs, sem.NetworkPeerAddress(host), sem.NetworkPeerPort(port))
+	}
+	return te.tracer.Start(ctx, strings.TrimPrefix(fullMethod, "/"), trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attrs...))
+}
+
+func (s *scoped) startClient(ctx context.Context, fullMethod string, cc *grpc.ClientConn) (context.Context, trace.Span) {
+	t := s.fromContext(ctx)
+	if t == nil {
+		return ctx, nil
+	}
+	attrs := rpcAttributes(fullMethod)
+	if cc != nil {
+		host, port := hostPort(cc.Target())
+		attrs = append(attrs, sem.ServerAddress(host))
+		if port > 0 {
+			attrs = append(attrs, sem.ServerPort(port))
+		}
+	}
+	ctx, sp := t.tracer.Start(ctx, strings.TrimPrefix(fullMethod, "/"), trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	t.propagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), sp
+}
+
+// rpcAttributes of full method like /pkg.Service/Method
+func rpcAttributes(fullMethod string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{sem.RPCSystemGRPC}
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if ok {
+		attrs = append(attrs, sem.RPCService(service), sem.RPCMethod(method))
+	}
+	return attrs
+}
+
+// finishRPC record status code, server only treats failures of server side as error
+func finishRPC(sp trace.Span, err error, server bool) {
+	st, _ := status.FromError(err)
+	sp.SetAttributes(sem.RPCGRPCStatusCodeKey.Int(int(st.Code())))
+	if err == nil {
+		return
+	}
+	if server {
+		switch st.Code() {
+		case grpccodes.Unknown, grpccodes.DeadlineExceeded, grpccodes.Unimplemented,
+			grpccodes.Internal, grpccodes.Unavailable, grpccodes.DataLoss:
+		default:
+			return
+		}
+	}
+	sp.SetStatus(codes.Error, st.Message())
+}
+
+func messageEvent(sp trace.Span, typ attribute.KeyValue, id int) {
+	sp.AddEvent("message", trace.WithAttributes(typ, sem.RPCMessageID(id)))
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx            context.Context
+	span           trace.Span
+	sent, received int
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+		messageEvent(s.span, sem.RPCMessageTypeSent, s.sent)
+	}
+	return err
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received++
+		messageEvent(s.span, sem.RPCMessageTypeReceived, s.received)
+	}
+	return err
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	desc           *grpc.StreamDesc
+	span           trace.Span
+	sent, received atomic.Int64
+	receiving      atomic.Int32
+	once           sync.Once
+}
+
+// finish end span once, io.EOF means success
+func (s *clientStream) finish(err error) {
+	s.once.Do(func() {
+		if err == io.EOF {
+			err = nil
+		}
+		finishRPC(s.span, err, false)
+		s.span.End()
+	})
+}
+
+func (s *clientStream) SendMsg(m any) error {
+	err := s.ClientStream.SendMsg(m)
+	if err != nil {
+		if err != io.EOF { //status of EOF comes from RecvMsg
+			s.finish(err)
+		}
+		return err
+	}
+	messageEvent(s.span, sem.RPCMessageTypeSent, int(s.sent.Add(1)))
+	return nil
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	s.receiving.Add(1)
+	defer s.receiving.Add(-1)
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.finish(err)
+		return err
+	}
+	messageEvent(s.span, sem.RPCMessageTypeReceived, int(s.received.Add(1)))
+	if !s.desc.ServerStreams {
+		s.finish(nil)
+	}
+	return nil
+}
+
+func (s *clientStream) Header() (metadata.MD, error) {
+	md, err := s.ClientStream.Header()
+	if err != nil {
+		s.finish(err)
+	}
+	return md, err
+}
+
+// metadataCarrier propagation.TextMapCarrier of grpc metadata
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,129 @@
+package ote
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestGrpcInterceptors(t *testing.T) {
+	rec, _ := setupTestTelemetry(t)
+	lis := bufconn.Listen(1 << 16)
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryServerInterceptor("server")),
+		grpc.StreamInterceptor(StreamServerInterceptor("server")),
+	)
+	hs := health.NewServer()
+	hs.SetServingStatus("known", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(srv, hs)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor("client")),
+		grpc.WithStreamInterceptor(StreamClientInterceptor("client")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.Close() }()
+	client := grpc_health_v1.NewHealthClient(conn)
+	ctx := context.Background()
+	if _, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "known"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "unknown"}); err == nil {
+		t.Fatal("expect not found")
+	}
+	cx, cancel := context.WithCancel(ctx)
+	stream, err := client.Watch(cx, &grpc_health_v1.HealthCheckRequest{Service: "known"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	for err == nil {
+		_, err = stream.Recv()
+	}
+	srv.GracefulStop()
+
+	kinds := map[trace.SpanKind]int{}
+	for _, s := range rec.Ended() {
+		kinds[s.SpanKind()]++
+	}
+	if kinds[trace.SpanKindServer] != 3 || kinds[trace.SpanKindClient] != 3 {
+		t.Fatalf("spans %v", kinds)
+	}
+	for _, s := range rec.Ended() {
+		switch {
+		case s.Name() != "grpc.health.v1.Health/Check" && s.Name() != "grpc.health.v1.Health/Watch":
+			t.Fatalf("name %s", s.Name())
+		case attr(s.Attributes(), "rpc.system").AsString() != "grpc" || attr(s.Attributes(), "rpc.method").AsString() == "":
+			t.Fatalf("attributes %v", s.Attributes())
+		case s.SpanKind() == trace.SpanKindServer && (!s.Parent().IsRemote() || !s.Parent().IsValid()):
+			t.Fatalf("server span should have remote parent %v", s.Parent())
+		}
+		code := attr(s.Attributes(), "rpc.grpc.status_code").AsInt64()
+		if code == 5 { //NotFound
+			if s.SpanKind() == trace.SpanKindClient && s.Status().Code != codes.Error {
+				t.Fatal("client span of NotFound should be error")
+			}
+			if s.SpanKind() == trace.SpanKindServer && s.Status().Code == codes.Error {
+				t.Fatal("server span of NotFound is not a server error")
+			}
+		}
+		if s.Name() == "grpc.health.v1.Health/Watch" && len(s.Events()) == 0 {
+			t.Fatalf("stream %s should have message events", s.SpanKind())
+		}
+	}
+}
+
+func TestGrpcClientStreamAbandoned(t *testing.T) {
+	rec, _ := setupTestTelemetry(t)
+	lis := bufconn.Listen(1 << 16)
+	srv := grpc.NewServer()
+	hs := health.NewServer()
+	hs.SetServingStatus("known", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(srv, hs)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStreamInterceptor(StreamClientInterceptor("client")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream, err := grpc_health_v1.NewHealthClient(conn).Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "known"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	//!! the caller stops reading and never cancels, closing the connection finishes the stream
+	_ = conn.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(rec.Ended()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("abandoned stream span never ended")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if s := rec.Ended()[0]; s.Status().Code != codes.Error || attr(s.Attributes(), "rpc.grpc.status_code").AsInt64() != 1 {
+		t.Fatalf("status %v %v", s.Status(), s.Attributes())
+	}
+}
@@ -0,0 +1,214 @@
+package ote
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	sem "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// durationBuckets recommended buckets of http request duration in seconds
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10}
+
+// Middleware returns Handler of scope as a router middleware
+func Middleware(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return Handler(scope, next)
+	}
+}
+
+// Handler wrap next with a server span of each request. The remote context is extracted by the global propagator,
+// the span is named by method and route of http.ServeMux pattern, and the Telemetry of scope is set to the request context.
+// Requests are passed through before telemetry setup.
+func Handler(scope string, next http.Handler) http.Handler {
+	return &handler{scoped: &scoped{scope: scope}, next: next}
+}
+
+type handler struct {
+	*scoped
+	next http.Handler
+}
+
+// requestDuration cached http request duration histogram of t
+func (t *telemetry) requestDuration(name, unit, description string) metric.Float64Histogram {
+	return instrument(t, "histogram", name, unit, func() (metric.Float64Histogram, error) {
+		return t.meter.Float64Histogram(name, metric.WithUnit(unit), metric.WithDescription(description),
+			metric.WithExplicitBucketBoundaries(durationBuckets...))
+	}, metric.Float64Histogram(noop.Float64Histogram{}))
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	i := h.telemetry()
+	if i == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	start := time.Now()
+	ctx := i.propagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	ctx = i.SetContext(ctx)
+	name := r.Method
+	if route := httpRoute(requestPattern(r)); route != "" {
+		name += " " + route
+	}
+	ctx, sp := i.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(serverAttributes(r)...))
+	rw := &responseWriter{ResponseWriter: w}
+	rx := r.WithContext(ctx)
+	defer func() {
+		//!! a panic of next is recorded as 500 then passed on to net/http
+		rec := recover()
+		//!! pattern is set by the inner ServeMux
+		attrs := []attribute.KeyValue{httpMethod(r.Method), sem.URLScheme(httpScheme(r))}
+		if route := httpRoute(requestPattern(rx)); route != "" {
+			sp.SetName(r.Method + " " + route)
+			attrs = append(attrs, sem.HTTPRoute(route))
+		}
+		status := rw.Status()
+		if rec != nil {
+			status = http.StatusInternalServerError
+		}
+		attrs = append(attrs, sem.HTTPResponseStatusCode(status))
+		if status >= 500 {
+			attrs = append(attrs, sem.ErrorTypeKey.String(strconv.Itoa(status)))
+			sp.SetStatus(codes.Error, http.StatusText(status))
+		}
+		sp.SetAttributes(attrs...)
+		sp.SetAttributes(sem.HTTPResponseBodySize(int(rw.size))) //not a metric attribute
+		i.RecordPanic(ctx, rec)
+		sp.End()
+		i.requestDuration(sem.HTTPServerRequestDurationName, sem.HTTPServerRequestDurationUnit, sem.HTTPServerRequestDurationDescription).
+			Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+		if rec != nil {
+			panic(rec)
+		}
+	}()
+	h.next.ServeHTTP(rw, rx)
+}
+
+// httpRoute the path of ServeMux pattern like "GET example.com/users/{id}"
+func httpRoute(pattern string) string {
+	if i := strings.IndexByte(pattern, ' '); i >= 0 {
+		pattern = strings.TrimLeft(pattern[i:], " \t")
+	}
+	if i := strings.IndexByte(pattern, '/'); i > 0 {
+		pattern = pattern[i:]
+	}
+	return pattern
+}
+
+// httpMethod unknown methods are recorded as _OTHER
+func httpMethod(method string) attribute.KeyValue {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return sem.HTTPRequestMethodKey.String(method)
+	default:
+		return sem.HTTPRequestMethodKey.String("_OTHER")
+	}
+}
+
+func httpScheme(r *http.Request) string {
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// hostPort split host and port of address, port is 0 if absent
+func hostPort(address string) (string, int) {
+	host, p, err := net.SplitHostPort(address)
+	if err != nil {
+		return address, 0
+	}
+	port, _ := strconv.Atoi(p)
+	return host, port
+}
+
+func serverAttributes(r *http.Request) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		httpMethod(r.Method),
+		sem.URLScheme(httpScheme(r)),
+		sem.URLPath(r.URL.Path),
+		sem.NetworkProtocolVersion(strings.TrimPrefix(r.Proto, "HTTP/")),
+	}
+	if host, port := hostPort(r.Host); host != "" {
+		attrs = append(attrs, sem.ServerAddress(host))
+		if port > 0 {
+			attrs = append(attrs, sem.ServerPort(port))
+		}
+	}
+	if host, _ := hostPort(r.RemoteAddr); host != "" {
+		attrs = append(attrs, sem.ClientAddress(host))
+	}
+	if ua := r.UserAgent(); ua != "" {
+		attrs = append(attrs, sem.UserAgentOriginal(ua))
+	}
+	if r.ContentLength > 0 {
+		attrs = append(attrs, sem.HTTPRequestBodySize(int(r.ContentLength)))
+	}
+	return attrs
+}
+
+// responseWriter records status and body size, Unwrap keeps http.ResponseController working
+type responseWriter struct {
+	http.ResponseWriter
+	status int
+	size   int64
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack for connection upgrades like websocket, the status is recorded as 101
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %T is not a http.Hijacker", http.ErrNotSupported, w.ResponseWriter)
+	}
+	c, rw, err := h.Hijack()
+	if err == nil && w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+	return c, rw, err
+}
+
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Status written status, 200 if not written
+func (w *responseWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
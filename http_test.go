@@ -0,0 +1,200 @@
+//go:debug httpmuxgo121=0
+
+package ote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// setupTestTelemetry install recording providers as global telemetry
+func setupTestTelemetry(t *testing.T) (*tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	rec := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	otel.SetTracerProvider(tp)
+	otel.SetMeterProvider(mp)
+	otel.SetTextMapPropagator(NewPropagator())
+	shutdown = func(context.Context) error { return nil }
+	t.Cleanup(func() {
+		shutdown = nil
+		_ = tp.Shutdown(context.Background())
+		_ = mp.Shutdown(context.Background())
+	})
+	return rec, reader
+}
+
+func attr(attrs []attribute.KeyValue, key string) attribute.Value {
+	for _, kv := range attrs {
+		if string(kv.Key) == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func metricNames(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Metrics {
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	m := map[string]metricdata.Metrics{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, x := range sm.Metrics {
+			m[x.Name] = x
+		}
+	}
+	return m
+}
+
+func TestHandler(t *testing.T) {
+	if !hasPattern {
+		t.Skip("http.route requires go1.23")
+	}
+	rec, reader := setupTestTelemetry(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if FromContext(r.Context()) == nil {
+			t.Error("telemetry should be in context")
+		}
+		_, _ = w.Write([]byte("user"))
+	})
+	mux.HandleFunc("POST /fail", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	srv := httptest.NewServer(Handler("test", mux))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/users/1", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	res, err = http.Post(srv.URL+"/fail", "text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+
+	spans := rec.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("spans %d", len(spans))
+	}
+	get, fail := spans[0], spans[1]
+	switch {
+	case get.Name() != "GET /users/{id}" || get.SpanKind() != trace.SpanKindServer:
+		t.Fatalf("span %s %s", get.Name(), get.SpanKind())
+	case get.Parent().TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" || !get.Parent().IsRemote():
+		t.Fatalf("parent %v", get.Parent())
+	case attr(get.Attributes(), "http.route").AsString() != "/users/{id}" || attr(get.Attributes(), "http.response.status_code").AsInt64() != 200:
+		t.Fatalf("attributes %v", get.Attributes())
+	case attr(get.Attributes(), "http.response.body.size").AsInt64() != 4:
+		t.Fatalf("attributes %v", get.Attributes())
+	case fail.Status().Code != codes.Error || fail.Parent().IsValid():
+		t.Fatalf("fail %v %v", fail.Status(), fail.Parent())
+	}
+	if _, ok := metricNames(t, reader)["http.server.request.duration"]; !ok {
+		t.Fatal("missing duration histogram")
+	}
+}
+
+func TestHttpRoute(t *testing.T) {
+	for pattern, want := range map[string]string{
+		"":                        "",
+		"/":                       "/",
+		"GET /users/{id}":         "/users/{id}",
+		"example.com/static/":     "/static/",
+		"POST example.com/a/{b}/": "/a/{b}/",
+	} {
+		if got := httpRoute(pattern); got != want {
+			t.Errorf("%q: want %q got %q", pattern, want, got)
+		}
+	}
+}
+
+func TestHandlerHijack(t *testing.T) {
+	rec, _ := setupTestTelemetry(t)
+	srv := httptest.NewServer(Handler("test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h, ok := w.(http.Hijacker)
+		if !ok {
+			t.Error("hijacker hidden")
+			return
+		}
+		c, rw, err := h.Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer func() { _ = c.Close() }()
+		_, _ = rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: test\r\nConnection: Upgrade\r\n\r\n")
+		_ = rw.Flush()
+	})))
+	defer srv.Close()
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "test")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("status %d", res.StatusCode)
+	}
+	//!! the client may read the upgrade before the handler returns
+	deadline := time.Now().Add(5 * time.Second)
+	for len(rec.Ended()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	spans := rec.Ended()
+	if len(spans) != 1 || attr(spans[0].Attributes(), "http.response.status_code").AsInt64() != 101 {
+		t.Fatalf("spans %v", spans)
+	}
+}
+
+func TestHandlerPanic(t *testing.T) {
+	if !hasPattern {
+		t.Skip("http.route requires go1.23")
+	}
+	rec, reader := setupTestTelemetry(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /boom/{id}", func(http.ResponseWriter, *http.Request) { panic("boom") })
+	h := Handler("test", mux)
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Fatalf("panic should pass through, got %v", r)
+			}
+		}()
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/boom/1", nil))
+	}()
+	spans := rec.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("spans %d", len(spans))
+	}
+	s := spans[0]
+	switch {
+	case s.Name() != "GET /boom/{id}" || s.Status().Code != codes.Error || len(s.Events()) != 1:
+		t.Fatalf("span %s %v %v", s.Name(), s.Status(), s.Events())
+	case attr(s.Attributes(), "http.response.status_code").AsInt64() != 500 || attr(s.Attributes(), "error.type").AsString() != "500":
+		t.Fatalf("attributes %v", s.Attributes())
+	}
+	if _, ok := metricNames(t, reader)["http.server.request.duration"]; !ok {
+		t.Fatal("missing duration of panicked request")
+	}
+}
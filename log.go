@@ -0,0 +1,19 @@
+package ote
+
+import (
+	"log/slog"
+
+	"go.opentelemetry.io/contrib/bridges/otelslog"
+)
+
+// NewLogHandler create slog.Handler emits records to the global LoggerProvider,
+// records carry trace and span id of the span in the context passed to the logger.
+// It's a noop handler before SetupTelemetry with log config.
+func NewLogHandler(scope string) slog.Handler {
+	return otelslog.NewHandler(scope, otelslog.WithVersion(Version))
+}
+
+// NewLogger create slog.Logger with NewLogHandler
+func NewLogger(scope string) *slog.Logger {
+	return slog.New(NewLogHandler(scope))
+}
@@ -0,0 +1,65 @@
+package ote
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ZenLiuCN/ote/otlp"
+	"github.com/ZenLiuCN/ote/resource"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/noop"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLogger(t *testing.T) {
+	setupTestTelemetry(t)
+	var mu sync.Mutex
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		body, _ = io.ReadAll(r.Body)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	lp, err := otlp.NewLoggerProvider(ctx, &otlp.LogConfig{
+		Protocol: otlp.ProtocolHTTP,
+		Endpoint: srv.URL,
+		Insecure: sql.NullBool{Bool: true, Valid: true},
+	}, &resource.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	global.SetLoggerProvider(lp)
+	defer global.SetLoggerProvider(noop.NewLoggerProvider())
+
+	cx, sp := NewTelemetry("test").StartSpan("log", ctx)
+	NewLogger("test").InfoContext(cx, "inside span")
+	sp.End()
+	if err = lp.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	req := new(collogspb.ExportLogsServiceRequest)
+	if err = proto.Unmarshal(body, req); err != nil {
+		t.Fatal(err)
+	}
+	r := req.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	id := sp.SpanContext()
+	traceID, spanID := id.TraceID(), id.SpanID()
+	switch {
+	case r.Body.GetStringValue() != "inside span":
+		t.Fatalf("body %v", r.Body)
+	case !bytes.Equal(r.TraceId, traceID[:]) || !bytes.Equal(r.SpanId, spanID[:]):
+		t.Fatalf("record ids %x %x, span %s %s", r.TraceId, r.SpanId, traceID, spanID)
+	}
+}
@@ -0,0 +1,115 @@
+package ote
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sem "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var messaging = &scoped{scope: "github.com/ZenLiuCN/ote/messaging"}
+
+// MessageHeader a header of message like kafka record header
+type MessageHeader struct {
+	Key   string
+	Value []byte
+}
+
+// MessageHeaders propagation.TextMapCarrier of header slice, use as pointer to let Set append
+type MessageHeaders []MessageHeader
+
+func (h *MessageHeaders) Get(key string) string {
+	for _, x := range *h {
+		if x.Key == key {
+			return string(x.Value)
+		}
+	}
+	return ""
+}
+
+func (h *MessageHeaders) Set(key, value string) {
+	for i, x := range *h {
+		if x.Key == key {
+			(*h)[i].Value = []byte(value)
+			return
+		}
+	}
+	*h = append(*h, MessageHeader{Key: key, Value: []byte(value)})
+}
+
+func (h *MessageHeaders) Keys() []string {
+	keys := make([]string, len(*h))
+	for i, x := range *h {
+		keys[i] = x.Key
+	}
+	return keys
+}
+
+// InjectMessage inject context into message headers, a map[string]string can be used as propagation.MapCarrier
+func InjectMessage(ctx context.Context, headers propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, headers)
+}
+
+// ExtractMessage extract the producer context of message headers into ctx
+func ExtractMessage(ctx context.Context, headers propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, headers)
+}
+
+// StartProducer start a producer span of sending to destination and inject it into headers
+func StartProducer(ctx context.Context, system, destination string, headers propagation.TextMapCarrier, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	t := messaging.fromContext(ctx)
+	if t == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	ctx, sp := t.tracer.Start(ctx, "publish "+destination,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(messageAttributes(system, destination, sem.MessagingOperationTypePublish)...),
+		trace.WithAttributes(attrs...))
+	InjectMessage(ctx, headers)
+	return ctx, sp
+}
+
+// StartConsumer start a consumer span of processing a message from destination as child of the producer span
+func StartConsumer(ctx context.Context, system, destination string, headers propagation.TextMapCarrier, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	t := messaging.fromContext(ctx)
+	if t == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	ctx = ExtractMessage(ctx, headers)
+	return t.tracer.Start(ctx, "process "+destination,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(messageAttributes(system, destination, sem.MessagingOperationTypeDeliver)...),
+		trace.WithAttributes(attrs...))
+}
+
+// StartBatchConsumer start a consumer span of processing messages from destination, which stays in the trace of ctx
+// and links to each producer span instead of parenting.
+func StartBatchConsumer(ctx context.Context, system, destination string, headers []propagation.TextMapCarrier, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	t := messaging.fromContext(ctx)
+	if t == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	links := make([]trace.Link, 0, len(headers))
+	for _, h := range headers {
+		if sc := trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(context.Background(), h)); sc.IsValid() {
+			links = append(links, trace.Link{SpanContext: sc})
+		}
+	}
+	return t.tracer.Start(ctx, "process "+destination,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(links...),
+		trace.WithAttributes(messageAttributes(system, destination, sem.MessagingOperationTypeDeliver)...),
+		trace.WithAttributes(sem.MessagingBatchMessageCount(len(headers))),
+		trace.WithAttributes(attrs...))
+}
+
+func messageAttributes(system, destination string, operation attribute.KeyValue) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		sem.MessagingSystemKey.String(system),
+		sem.MessagingDestinationName(destination),
+		operation,
+	}
+}
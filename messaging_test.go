@@ -0,0 +1,52 @@
+package ote
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMessaging(t *testing.T) {
+	rec, _ := setupTestTelemetry(t)
+	ctx := context.Background()
+	var kafka MessageHeaders
+	_, p1 := StartProducer(ctx, "kafka", "orders", &kafka)
+	p1.End()
+	if kafka.Get("traceparent") == "" {
+		t.Fatalf("headers %v", kafka)
+	}
+	amqp := propagation.MapCarrier{}
+	_, p2 := StartProducer(ctx, "rabbitmq", "orders", amqp)
+	p2.End()
+
+	_, c := StartConsumer(ctx, "kafka", "orders", &kafka)
+	c.End()
+	_, b := StartBatchConsumer(ctx, "kafka", "orders", []propagation.TextMapCarrier{&kafka, amqp, propagation.MapCarrier{}})
+	b.End()
+
+	spans := rec.Ended()
+	consumer, batch := spans[2], spans[3]
+	switch {
+	case spans[0].SpanKind() != trace.SpanKindProducer || spans[0].Name() != "publish orders":
+		t.Fatalf("producer %s %s", spans[0].Name(), spans[0].SpanKind())
+	case consumer.Parent().SpanID() != p1.SpanContext().SpanID() || consumer.SpanKind() != trace.SpanKindConsumer:
+		t.Fatalf("consumer parent %v", consumer.Parent())
+	case batch.Parent().IsValid() || len(batch.Links()) != 2:
+		t.Fatalf("batch parent %v links %v", batch.Parent(), batch.Links())
+	case batch.Links()[1].SpanContext.SpanID() != p2.SpanContext().SpanID():
+		t.Fatalf("batch links %v", batch.Links())
+	case attr(batch.Attributes(), "messaging.batch.message_count").AsInt64() != 3 || attr(batch.Attributes(), "messaging.system").AsString() != "kafka":
+		t.Fatalf("batch attributes %v", batch.Attributes())
+	}
+}
+
+func TestMessageHeaders(t *testing.T) {
+	h := MessageHeaders{{Key: "k", Value: []byte("v")}}
+	h.Set("k", "x")
+	h.Set("n", "y")
+	if len(h) != 2 || h.Get("k") != "x" || h.Get("n") != "y" || h.Keys()[1] != "n" {
+		t.Fatalf("headers %v", h)
+	}
+}
@@ -0,0 +1,154 @@
+package ote
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// metricBaggage baggage members added to attributes of Telemetry measurements, * for all, set by SetupTelemetry
+var metricBaggage atomic.Pointer[[]string]
+
+// instruments cache of Telemetry instruments by meter, kind, name and unit, cleared on shutdown
+var instruments sync.Map
+
+type instrumentKey struct {
+	meter      metric.Meter
+	kind       string
+	name, unit string
+}
+
+// ObserveFn callback of observable instruments, observe is valid only during the call
+type ObserveFn func(ctx context.Context, observe func(value float64, attrs ...attribute.KeyValue)) error
+
+// Counter monotonic counter of Telemetry
+type Counter struct {
+	i metric.Float64Counter
+}
+
+func (c Counter) Add(ctx context.Context, incr float64, attrs ...attribute.KeyValue) {
+	c.i.Add(ctx, incr, measureAttributes(ctx, attrs))
+}
+
+// UpDownCounter non-monotonic counter of Telemetry
+type UpDownCounter struct {
+	i metric.Float64UpDownCounter
+}
+
+func (c UpDownCounter) Add(ctx context.Context, incr float64, attrs ...attribute.KeyValue) {
+	c.i.Add(ctx, incr, measureAttributes(ctx, attrs))
+}
+
+// Histogram distribution of Telemetry
+type Histogram struct {
+	i metric.Float64Histogram
+}
+
+func (h Histogram) Record(ctx context.Context, value float64, attrs ...attribute.KeyValue) {
+	h.i.Record(ctx, value, measureAttributes(ctx, attrs))
+}
+
+// Gauge current value of Telemetry
+type Gauge struct {
+	i metric.Float64Gauge
+}
+
+func (g Gauge) Record(ctx context.Context, value float64, attrs ...attribute.KeyValue) {
+	g.i.Record(ctx, value, measureAttributes(ctx, attrs))
+}
+
+// measureAttributes attrs with members of context baggage when enabled
+func measureAttributes(ctx context.Context, attrs []attribute.KeyValue) metric.MeasurementOption {
+	keys := metricBaggage.Load()
+	if keys == nil || len(*keys) == 0 {
+		return metric.WithAttributes(attrs...)
+	}
+	members := baggage.FromContext(ctx).Members()
+	if len(members) == 0 {
+		return metric.WithAttributes(attrs...)
+	}
+	all := slices.Contains(*keys, "*")
+	out := make([]attribute.KeyValue, 0, len(attrs)+len(members))
+	for _, m := range members {
+		if all || slices.Contains(*keys, m.Key()) {
+			out = append(out, attribute.String(m.Key(), m.Value()))
+		}
+	}
+	//!! explicit attributes win over baggage of same key
+	return metric.WithAttributes(append(out, attrs...)...)
+}
+
+// instrument cached or created by fn, failures are handled and replaced by fallback
+func instrument[T any](t *telemetry, kind, name, unit string, fn func() (T, error), fallback T) T {
+	k := instrumentKey{meter: t.meter, kind: kind, name: name, unit: unit}
+	if v, ok := instruments.Load(k); ok {
+		return v.(T)
+	}
+	i, err := fn()
+	if err != nil {
+		t.HandleError(err)
+		return fallback
+	}
+	v, _ := instruments.LoadOrStore(k, i)
+	return v.(T)
+}
+
+func (t *telemetry) Counter(name, unit string) Counter {
+	return Counter{instrument(t, "counter", name, unit, func() (metric.Float64Counter, error) {
+		return t.meter.Float64Counter(name, metric.WithUnit(unit))
+	}, metric.Float64Counter(noop.Float64Counter{}))}
+}
+
+func (t *telemetry) UpDownCounter(name, unit string) UpDownCounter {
+	return UpDownCounter{instrument(t, "updowncounter", name, unit, func() (metric.Float64UpDownCounter, error) {
+		return t.meter.Float64UpDownCounter(name, metric.WithUnit(unit))
+	}, metric.Float64UpDownCounter(noop.Float64UpDownCounter{}))}
+}
+
+func (t *telemetry) Histogram(name, unit string, buckets ...float64) Histogram {
+	return Histogram{instrument(t, "histogram", name, unit, func() (metric.Float64Histogram, error) {
+		if len(buckets) == 0 {
+			return t.meter.Float64Histogram(name, metric.WithUnit(unit))
+		}
+		return t.meter.Float64Histogram(name, metric.WithUnit(unit), metric.WithExplicitBucketBoundaries(buckets...))
+	}, metric.Float64Histogram(noop.Float64Histogram{}))}
+}
+
+func (t *telemetry) Gauge(name, unit string) Gauge {
+	return Gauge{instrument(t, "gauge", name, unit, func() (metric.Float64Gauge, error) {
+		return t.meter.Float64Gauge(name, metric.WithUnit(unit))
+	}, metric.Float64Gauge(noop.Float64Gauge{}))}
+}
+
+func (t *telemetry) ObservableCounter(name, unit string, fn ObserveFn) (metric.Registration, error) {
+	return t.observe(instrument(t, "observablecounter", name, unit, func() (metric.Float64Observable, error) {
+		return t.meter.Float64ObservableCounter(name, metric.WithUnit(unit))
+	}, metric.Float64Observable(noop.Float64ObservableCounter{})), fn)
+}
+
+func (t *telemetry) ObservableUpDownCounter(name, unit string, fn ObserveFn) (metric.Registration, error) {
+	return t.observe(instrument(t, "observableupdowncounter", name, unit, func() (metric.Float64Observable, error) {
+		return t.meter.Float64ObservableUpDownCounter(name, metric.WithUnit(unit))
+	}, metric.Float64Observable(noop.Float64ObservableUpDownCounter{})), fn)
+}
+
+func (t *telemetry) ObservableGauge(name, unit string, fn ObserveFn) (metric.Registration, error) {
+	return t.observe(instrument(t, "observablegauge", name, unit, func() (metric.Float64Observable, error) {
+		return t.meter.Float64ObservableGauge(name, metric.WithUnit(unit))
+	}, metric.Float64Observable(noop.Float64ObservableGauge{})), fn)
+}
+
+// observe register fn as a callback of i, each call registers another callback
+func (t *telemetry) observe(i metric.Float64Observable, fn ObserveFn) (metric.Registration, error) {
+	return t.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		return fn(ctx, func(value float64, attrs ...attribute.KeyValue) {
+			o.ObserveFloat64(i, value, metric.WithAttributes(attrs...))
+		})
+	}, i)
+}
@@ -0,0 +1,61 @@
+package ote
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMetrics(t *testing.T) {
+	_, reader := setupTestTelemetry(t)
+	metricBaggage.Store(&[]string{"tenant"})
+	t.Cleanup(func() { metricBaggage.Store(nil) })
+	te := NewTelemetry("test")
+	tenant, _ := baggage.NewMember("tenant", "acme")
+	user, _ := baggage.NewMember("user", "alice")
+	b, _ := baggage.New(tenant, user)
+	ctx := baggage.ContextWithBaggage(context.Background(), b)
+
+	for i := 0; i < 3; i++ {
+		te.Counter("jobs", "{job}").Add(ctx, 1, attribute.String("queue", "q"))
+	}
+	te.UpDownCounter("inflight", "{job}").Add(ctx, -2)
+	te.Histogram("latency", "s", 1, 2).Record(ctx, 1.5)
+	te.Gauge("temperature", "Cel").Record(ctx, 42)
+	if _, err := te.ObservableGauge("queue.size", "{job}", func(_ context.Context, observe func(float64, ...attribute.KeyValue)) error {
+		observe(7, attribute.String("queue", "q"))
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if NewTelemetry("test").Counter("jobs", "{job}") != te.Counter("jobs", "{job}") {
+		t.Fatal("instrument not cached")
+	}
+
+	ms := metricNames(t, reader)
+	jobs := ms["jobs"].Data.(metricdata.Sum[float64]).DataPoints
+	if len(jobs) != 1 || jobs[0].Value != 3 {
+		t.Fatalf("jobs %+v", jobs)
+	}
+	if v, _ := jobs[0].Attributes.Value("tenant"); v.AsString() != "acme" {
+		t.Fatalf("baggage %v", jobs[0].Attributes)
+	}
+	if jobs[0].Attributes.HasValue("user") {
+		t.Fatalf("baggage not configured %v", jobs[0].Attributes)
+	}
+	if v := ms["inflight"].Data.(metricdata.Sum[float64]).DataPoints[0].Value; v != -2 {
+		t.Fatalf("inflight %v", v)
+	}
+	if h := ms["latency"].Data.(metricdata.Histogram[float64]).DataPoints[0]; h.Count != 1 || len(h.Bounds) != 2 {
+		t.Fatalf("latency %+v", h)
+	}
+	if v := ms["temperature"].Data.(metricdata.Gauge[float64]).DataPoints[0].Value; v != 42 {
+		t.Fatalf("temperature %v", v)
+	}
+	if v := ms["queue.size"].Data.(metricdata.Gauge[float64]).DataPoints[0].Value; v != 7 {
+		t.Fatalf("queue.size %v", v)
+	}
+}
@@ -0,0 +1,106 @@
+package otlp
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	b3Single  = "b3"
+	b3TraceID = "x-b3-traceid"
+	b3SpanID  = "x-b3-spanid"
+	b3Sampled = "x-b3-sampled"
+	b3Flags   = "x-b3-flags"
+)
+
+// b3Propagator zipkin b3 propagation, injects the single b3 header or the X-B3-* headers when multi.
+// Both encodings are extracted, the single header first. Debug flag is extracted as sampled.
+type b3Propagator struct {
+	multi bool
+}
+
+func (p b3Propagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := api.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	sampled := "0"
+	if sc.IsSampled() {
+		sampled = "1"
+	}
+	if !p.multi {
+		carrier.Set(b3Single, sc.TraceID().String()+"-"+sc.SpanID().String()+"-"+sampled)
+		return
+	}
+	carrier.Set(b3TraceID, sc.TraceID().String())
+	carrier.Set(b3SpanID, sc.SpanID().String())
+	carrier.Set(b3Sampled, sampled)
+}
+
+func (p b3Propagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	var sc api.SpanContext
+	if v := carrier.Get(b3Single); v != "" {
+		//!! traceid-spanid[-sampling[-parentspanid]], a sampling only value carries no context
+		if parts := strings.Split(v, "-"); len(parts) >= 2 && len(parts) <= 4 {
+			parts = append(parts, "")
+			sc = b3SpanContext(parts[0], parts[1], parts[2], "")
+		}
+	} else {
+		sc = b3SpanContext(carrier.Get(b3TraceID), carrier.Get(b3SpanID), carrier.Get(b3Sampled), carrier.Get(b3Flags))
+	}
+	if !sc.IsValid() {
+		return ctx
+	}
+	return api.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+func (p b3Propagator) Fields() []string {
+	if p.multi {
+		return []string{b3TraceID, b3SpanID, b3Sampled}
+	}
+	return []string{b3Single}
+}
+
+// b3SpanContext of 16 or 32 hex digits trace id, invalid values give an invalid context
+func b3SpanContext(traceID, spanID, sampled, flags string) api.SpanContext {
+	if len(traceID) != 16 && len(traceID) != 32 || len(spanID) != 16 {
+		return api.SpanContext{}
+	}
+	cfg := api.SpanContextConfig{Remote: true}
+	var ok bool
+	if cfg.TraceID, ok = traceIDFromHex(traceID); !ok {
+		return api.SpanContext{}
+	}
+	if cfg.SpanID, ok = spanIDFromHex(spanID); !ok {
+		return api.SpanContext{}
+	}
+	switch {
+	case flags == "1", sampled == "1", sampled == "d", sampled == "true":
+		cfg.TraceFlags = api.FlagsSampled
+	case sampled == "", sampled == "0", sampled == "false":
+	default:
+		return api.SpanContext{}
+	}
+	return api.NewSpanContext(cfg)
+}
+
+// traceIDFromHex of up to 32 lower hex digits, shorter ids are left padded with zeros
+func traceIDFromHex(s string) (api.TraceID, bool) {
+	if len(s) == 0 || len(s) > 32 {
+		return api.TraceID{}, false
+	}
+	id, err := api.TraceIDFromHex(strings.Repeat("0", 32-len(s)) + s)
+	return id, err == nil
+}
+
+// spanIDFromHex of up to 16 lower hex digits, shorter ids are left padded with zeros
+func spanIDFromHex(s string) (api.SpanID, bool) {
+	if len(s) == 0 || len(s) > 16 {
+		return api.SpanID{}, false
+	}
+	id, err := api.SpanIDFromHex(strings.Repeat("0", 16-len(s)) + s)
+	return id, err == nil
+}
@@ -0,0 +1,306 @@
+package otlp
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/ZenLiuCN/ote/resource"
+)
+
+// FromEnv fill unset fields from the standard OTEL_* environment variables,
+// values already set in code take precedence. Unknown or malformed values are
+// reported as joined *EnvError.
+//
+// The metric and log configs are filled when already present or enabled by
+// OTEL_METRICS_EXPORTER=otlp and OTEL_LOGS_EXPORTER=otlp.
+//
+// OTEL_RESOURCE_ATTRIBUTES is only validated here, the attributes are added by
+// ParseResource unless Config.Env is disabled.
+func (c *TraceConfig) FromEnv() error {
+	r := new(envReader)
+	r.bool(&c.Disabled, "OTEL_SDK_DISABLED")
+	//!! exporter
+	{
+		r.oneOf(&c.Protocol, []string{ProtocolGRPC, ProtocolHTTP, ProtocolJSON}, "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL")
+		r.endpoint(&c.Endpoint, c.Protocol, "/v1/traces", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "OTEL_EXPORTER_OTLP_ENDPOINT")
+		r.headers(&c.Headers, "OTEL_EXPORTER_OTLP_TRACES_HEADERS", "OTEL_EXPORTER_OTLP_HEADERS")
+		r.oneOf(&c.Compress, []string{"gzip", "none"}, "OTEL_EXPORTER_OTLP_TRACES_COMPRESSION", "OTEL_EXPORTER_OTLP_COMPRESSION")
+		r.millis(&c.Timeout, "OTEL_EXPORTER_OTLP_TRACES_TIMEOUT", "OTEL_EXPORTER_OTLP_TIMEOUT")
+		r.bool(&c.Insecure, "OTEL_EXPORTER_OTLP_TRACES_INSECURE", "OTEL_EXPORTER_OTLP_INSECURE")
+	}
+	//!! batch
+	{
+		r.millis(&c.ExportBatchTimeout, "OTEL_BSP_SCHEDULE_DELAY")
+		r.millis(&c.ExportTimeout, "OTEL_BSP_EXPORT_TIMEOUT")
+		r.int32(&c.QueueSize, "OTEL_BSP_MAX_QUEUE_SIZE")
+		r.int32(&c.ExportBatchSize, "OTEL_BSP_MAX_EXPORT_BATCH_SIZE")
+	}
+	//!! propagator
+	if n, v, ok := r.lookup("OTEL_PROPAGATORS"); ok && len(c.Propagators) == 0 {
+		for _, p := range strings.Split(v, ",") {
+			if p = strings.TrimSpace(p); p == "" {
+				continue
+			}
+			if _, ok = propagators[p]; !ok {
+				r.fail(n, v, fmt.Errorf("unknown propagator %s", p))
+				continue
+			}
+			c.Propagators = append(c.Propagators, p)
+		}
+	}
+	//!! sampler
+	if c.Sampler == nil {
+		c.Sampler = r.sampler()
+	}
+	//!! metric
+	if c.Metric != nil || r.exporter("OTEL_METRICS_EXPORTER") {
+		if c.Metric == nil {
+			c.Metric = new(MetricConfig)
+		}
+		m := c.Metric
+		r.oneOf(&m.Protocol, []string{ProtocolGRPC, ProtocolHTTP}, "OTEL_EXPORTER_OTLP_METRICS_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL")
+		r.endpoint(&m.Endpoint, m.Protocol, "/v1/metrics", "OTEL_EXPORTER_OTLP_METRICS_ENDPOINT", "OTEL_EXPORTER_OTLP_ENDPOINT")
+		r.headers(&m.Headers, "OTEL_EXPORTER_OTLP_METRICS_HEADERS", "OTEL_EXPORTER_OTLP_HEADERS")
+		r.oneOf(&m.Compress, []string{"gzip", "none"}, "OTEL_EXPORTER_OTLP_METRICS_COMPRESSION", "OTEL_EXPORTER_OTLP_COMPRESSION")
+		r.millis(&m.Timeout, "OTEL_EXPORTER_OTLP_METRICS_TIMEOUT", "OTEL_EXPORTER_OTLP_TIMEOUT")
+		r.bool(&m.Insecure, "OTEL_EXPORTER_OTLP_METRICS_INSECURE", "OTEL_EXPORTER_OTLP_INSECURE")
+		r.millis(&m.Interval, "OTEL_METRIC_EXPORT_INTERVAL")
+		r.millis(&m.ExportTimeout, "OTEL_METRIC_EXPORT_TIMEOUT")
+		r.oneOf(&m.Temporality, []string{"cumulative", "delta", "lowmemory"}, "OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE")
+		r.oneOf(&m.Aggregation, []string{"explicit_bucket_histogram", "base2_exponential_bucket_histogram"}, "OTEL_EXPORTER_OTLP_METRICS_DEFAULT_HISTOGRAM_AGGREGATION")
+	}
+	//!! log
+	if c.Log != nil || r.exporter("OTEL_LOGS_EXPORTER") {
+		if c.Log == nil {
+			c.Log = new(LogConfig)
+		}
+		l := c.Log
+		r.oneOf(&l.Protocol, []string{ProtocolGRPC, ProtocolHTTP}, "OTEL_EXPORTER_OTLP_LOGS_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL")
+		r.endpoint(&l.Endpoint, l.Protocol, "/v1/logs", "OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", "OTEL_EXPORTER_OTLP_ENDPOINT")
+		r.headers(&l.Headers, "OTEL_EXPORTER_OTLP_LOGS_HEADERS", "OTEL_EXPORTER_OTLP_HEADERS")
+		r.oneOf(&l.Compress, []string{"gzip", "none"}, "OTEL_EXPORTER_OTLP_LOGS_COMPRESSION", "OTEL_EXPORTER_OTLP_COMPRESSION")
+		r.millis(&l.Timeout, "OTEL_EXPORTER_OTLP_LOGS_TIMEOUT", "OTEL_EXPORTER_OTLP_TIMEOUT")
+		r.bool(&l.Insecure, "OTEL_EXPORTER_OTLP_LOGS_INSECURE", "OTEL_EXPORTER_OTLP_INSECURE")
+		r.millis(&l.ExportInterval, "OTEL_BLRP_SCHEDULE_DELAY")
+		r.millis(&l.ExportTimeout, "OTEL_BLRP_EXPORT_TIMEOUT")
+		r.int32(&l.QueueSize, "OTEL_BLRP_MAX_QUEUE_SIZE")
+		r.int32(&l.ExportMaxBatch, "OTEL_BLRP_MAX_EXPORT_BATCH_SIZE")
+	}
+	//!! resource
+	if c.Config == nil {
+		c.Config = new(Config)
+	}
+	r.errs = append(r.errs, c.Config.FromEnv())
+	return errors.Join(r.errs...)
+}
+
+type envReader struct {
+	errs []error
+}
+
+// lookup the first present variable
+func (r *envReader) lookup(names ...string) (name, value string, ok bool) {
+	for _, name = range names {
+		if value, ok = os.LookupEnv(name); ok {
+			return name, strings.TrimSpace(value), ok
+		}
+	}
+	return
+}
+func (r *envReader) fail(name, value string, err error) {
+	r.errs = append(r.errs, &EnvError{Name: name, Value: value, Err: err})
+}
+
+func (r *envReader) oneOf(dst *string, values []string, names ...string) {
+	if *dst != "" {
+		return
+	}
+	if n, v, ok := r.lookup(names...); ok {
+		if !slices.Contains(values, v) {
+			r.fail(n, v, fmt.Errorf("not one of %s", strings.Join(values, "|")))
+			return
+		}
+		*dst = v
+	}
+}
+
+// endpoint the signal path is appended to the generic endpoint for http protocols
+func (r *envReader) endpoint(dst *string, protocol, path string, signal, generic string) {
+	if *dst != "" {
+		return
+	}
+	if n, v, ok := r.lookup(signal, generic); ok {
+		u, err := url.Parse(v)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			r.fail(n, v, fmt.Errorf("not a valid url"))
+			return
+		}
+		if n == generic && protocol != "" && protocol != ProtocolGRPC {
+			u.Path = strings.TrimSuffix(u.Path, "/") + path
+		}
+		*dst = u.String()
+	}
+}
+
+func (r *envReader) headers(dst *map[string]string, names ...string) {
+	n, v, ok := r.lookup(names...)
+	if !ok || v == "" {
+		return
+	}
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(v, ",") {
+		key, value, found := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			r.fail(n, v, fmt.Errorf("header %q not in form of key=value", pair))
+			return
+		}
+		value, err := url.PathUnescape(strings.TrimSpace(value))
+		if err != nil {
+			r.fail(n, v, fmt.Errorf("header %q: %w", key, err))
+			return
+		}
+		headers[key] = value
+	}
+	//!! the map set in code may be shared, merge into a copy
+	maps.Copy(headers, *dst)
+	*dst = headers
+}
+
+func (r *envReader) millis(dst *time.Duration, names ...string) {
+	if *dst != 0 {
+		return
+	}
+	if n, v, ok := r.lookup(names...); ok {
+		ms, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || ms < 0 {
+			r.fail(n, v, fmt.Errorf("not a non-negative milliseconds integer"))
+			return
+		}
+		*dst = time.Duration(ms) * time.Millisecond
+	}
+}
+
+func (r *envReader) int32(dst *sql.NullInt32, names ...string) {
+	if dst.Valid {
+		return
+	}
+	if n, v, ok := r.lookup(names...); ok {
+		i, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || i <= 0 {
+			r.fail(n, v, fmt.Errorf("not a positive integer"))
+			return
+		}
+		dst.Int32 = int32(i)
+		dst.Valid = true
+	}
+}
+
+func (r *envReader) bool(dst *sql.NullBool, names ...string) {
+	if dst.Valid {
+		return
+	}
+	if n, v, ok := r.lookup(names...); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			r.fail(n, v, fmt.Errorf("not a boolean"))
+			return
+		}
+		dst.Bool = b
+		dst.Valid = true
+	}
+}
+
+// exporter check if otlp is one of the exporters of a signal
+func (r *envReader) exporter(name string) bool {
+	_, v, ok := r.lookup(name)
+	if !ok {
+		return false
+	}
+	for _, e := range strings.Split(v, ",") {
+		switch e = strings.TrimSpace(e); e {
+		case "otlp":
+			return true
+		case "none", "prometheus", "console", "logging", "":
+		default:
+			r.fail(name, v, fmt.Errorf("unknown exporter %q", e))
+		}
+	}
+	return false
+}
+
+func (r *envReader) sampler() *SamplerConfig {
+	n, v, ok := r.lookup("OTEL_TRACES_SAMPLER")
+	if !ok {
+		return nil
+	}
+	s := new(SamplerConfig)
+	switch v {
+	case "always_on":
+		s.Name = "always"
+	case "always_off":
+		s.Name = "never"
+	case "traceidratio":
+		s.Name = "ratio"
+	case "parentbased_always_on":
+		s.Name, s.Based = "parent", "always"
+	case "parentbased_always_off":
+		s.Name, s.Based = "parent", "never"
+	case "parentbased_traceidratio":
+		s.Name, s.Based = "parent", "ratio"
+	case "jaeger_remote":
+		s.Name = "remote"
+	case "parentbased_jaeger_remote":
+		s.Name, s.Based = "parent", "remote"
+	default:
+		r.fail(n, v, fmt.Errorf("unsupported sampler"))
+		return nil
+	}
+	if n, v, ok = r.lookup("OTEL_TRACES_SAMPLER_ARG"); ok && (s.Name == "ratio" || s.Based == "ratio") {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 || f > 1 {
+			r.fail(n, v, fmt.Errorf("not a ratio in [0,1]"))
+		} else {
+			s.Ratio = sql.NullFloat64{Float64: f, Valid: true}
+		}
+	}
+	if n, v, ok = r.lookup("OTEL_TRACES_SAMPLER_ARG"); ok && (s.Name == "remote" || s.Based == "remote") {
+		r.remote(n, v, s)
+	}
+	return s
+}
+
+// remote parse jaeger remote sampler arg like endpoint=http://localhost:5778/sampling,pollingIntervalMs=5000,initialSamplingRate=0.25
+func (r *envReader) remote(n, v string, s *SamplerConfig) {
+	for _, kv := range strings.Split(v, ",") {
+		k, x, _ := strings.Cut(strings.TrimSpace(kv), "=")
+		switch k {
+		case "endpoint":
+			s.Endpoint = x
+		case "pollingIntervalMs":
+			ms, err := strconv.ParseInt(x, 10, 64)
+			if err != nil || ms < 0 {
+				r.fail(n, v, fmt.Errorf("invalid pollingIntervalMs"))
+				continue
+			}
+			s.Interval = time.Duration(ms) * time.Millisecond
+		case "initialSamplingRate":
+			f, err := strconv.ParseFloat(x, 64)
+			if err != nil || f < 0 || f > 1 {
+				r.fail(n, v, fmt.Errorf("initialSamplingRate not a ratio in [0,1]"))
+				continue
+			}
+			s.Default = &SamplerConfig{Name: "ratio", Ratio: sql.NullFloat64{Float64: f, Valid: true}}
+		default:
+			r.fail(n, v, fmt.Errorf("unknown jaeger_remote argument %s", k))
+		}
+	}
+}
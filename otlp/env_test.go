@@ -0,0 +1,98 @@
+package otlp
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/ZenLiuCN/ote/resource"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("OTEL_SDK_DISABLED", "false")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/protobuf")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://collector:4318/")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_HEADERS", "Authorization=Bearer%20token,x-tenant=a")
+	t.Setenv("OTEL_EXPORTER_OTLP_TIMEOUT", "5000")
+	t.Setenv("OTEL_TRACES_SAMPLER", "parentbased_traceidratio")
+	t.Setenv("OTEL_TRACES_SAMPLER_ARG", "0.25")
+	t.Setenv("OTEL_BSP_SCHEDULE_DELAY", "200")
+	t.Setenv("OTEL_BSP_MAX_QUEUE_SIZE", "512")
+	t.Setenv("OTEL_METRICS_EXPORTER", "otlp,prometheus")
+	t.Setenv("OTEL_SERVICE_NAME", "env-service")
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "deployment.environment=prod,team=a%20b")
+	shared := map[string]string{"x-tenant": "code"}
+	c := &TraceConfig{
+		Headers: shared,
+		Config:  &Config{},
+	}
+	if err := c.FromEnv(); err != nil {
+		t.Fatal(err)
+	}
+	switch {
+	case !c.Disabled.Valid || c.Disabled.Bool:
+		t.Fatalf("disabled %v", c.Disabled)
+	case c.Protocol != ProtocolHTTP || c.Endpoint != "http://collector:4318/v1/traces":
+		t.Fatalf("exporter %s %s", c.Protocol, c.Endpoint)
+	case c.Headers["Authorization"] != "Bearer token" || c.Headers["x-tenant"] != "code":
+		t.Fatalf("headers %v", c.Headers)
+	case len(shared) != 1:
+		t.Fatalf("headers set in code mutated %v", shared)
+	case c.Timeout != 5*time.Second || c.ExportBatchTimeout != 200*time.Millisecond || c.QueueSize.Int32 != 512:
+		t.Fatalf("durations %v %v %v", c.Timeout, c.ExportBatchTimeout, c.QueueSize)
+	case c.Sampler == nil || c.Sampler.Name != "parent" || c.Sampler.Based != "ratio" || c.Sampler.Ratio.Float64 != 0.25:
+		t.Fatalf("sampler %+v", c.Sampler)
+	case c.Metric == nil || c.Metric.Endpoint != "http://collector:4318/v1/metrics":
+		t.Fatalf("metric %+v", c.Metric)
+	case c.Log != nil:
+		t.Fatalf("log %+v", c.Log)
+	case c.Service.String != "env-service":
+		t.Fatalf("service %v", c.Service)
+	}
+}
+
+func TestFromEnvPrecedence(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://collector:4317")
+	t.Setenv("OTEL_TRACES_SAMPLER", "always_off")
+	t.Setenv("OTEL_EXPORTER_OTLP_INSECURE", "true")
+	t.Setenv("OTEL_SERVICE_NAME", "env-service")
+	c := &TraceConfig{
+		Endpoint: "http://code:4317",
+		Insecure: sql.NullBool{Valid: true},
+		Sampler:  &SamplerConfig{Name: "always"},
+		Config:   &Config{Service: sql.NullString{String: "code", Valid: true}},
+	}
+	if err := c.FromEnv(); err != nil {
+		t.Fatal(err)
+	}
+	if c.Endpoint != "http://code:4317" || c.Insecure.Bool || c.Sampler.Name != "always" || c.Service.String != "code" {
+		t.Fatalf("env override code values: %+v", c)
+	}
+}
+
+func TestFromEnvErrors(t *testing.T) {
+	t.Setenv("OTEL_TRACES_SAMPLER", "sometimes")
+	t.Setenv("OTEL_BSP_MAX_QUEUE_SIZE", "-1")
+	t.Setenv("OTEL_EXPORTER_OTLP_COMPRESSION", "zstd")
+	t.Setenv("OTEL_EXPORTER_OTLP_TIMEOUT", "5s")
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "team")
+	c := new(TraceConfig)
+	err := c.FromEnv()
+	if err == nil {
+		t.Fatal("expect errors")
+	}
+	names := map[string]bool{}
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var env *EnvError
+		if !errors.As(e, &env) {
+			t.Fatalf("not an EnvError: %v", e)
+		}
+		names[env.Name] = true
+	}
+	for _, n := range []string{"OTEL_TRACES_SAMPLER", "OTEL_BSP_MAX_QUEUE_SIZE", "OTEL_EXPORTER_OTLP_COMPRESSION", "OTEL_EXPORTER_OTLP_TIMEOUT", "OTEL_RESOURCE_ATTRIBUTES"} {
+		if !names[n] {
+			t.Fatalf("missing error of %s in %v", n, err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package otlp
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+const jaegerHeader = "uber-trace-id"
+
+// jaegerPropagator jaeger uber-trace-id propagation of {trace-id}:{span-id}:{parent-span-id}:{flags},
+// ids may be shorter than their full length, the debug flag is extracted as sampled. Baggage is not propagated.
+type jaegerPropagator struct{}
+
+func (jaegerPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := api.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	flags := "0"
+	if sc.IsSampled() {
+		flags = "1"
+	}
+	carrier.Set(jaegerHeader, sc.TraceID().String()+":"+sc.SpanID().String()+":0:"+flags)
+}
+
+func (jaegerPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	v := carrier.Get(jaegerHeader)
+	if v == "" {
+		return ctx
+	}
+	if u, err := url.QueryUnescape(v); err == nil {
+		v = u
+	}
+	parts := strings.Split(v, ":")
+	if len(parts) != 4 {
+		return ctx
+	}
+	cfg := api.SpanContextConfig{Remote: true}
+	var ok bool
+	if cfg.TraceID, ok = traceIDFromHex(parts[0]); !ok {
+		return ctx
+	}
+	if cfg.SpanID, ok = spanIDFromHex(parts[1]); !ok {
+		return ctx
+	}
+	flags, err := strconv.ParseUint(parts[3], 16, 8)
+	if err != nil {
+		return ctx
+	}
+	if flags&0x3 != 0 { //sampled or debug
+		cfg.TraceFlags = api.FlagsSampled
+	}
+	return api.ContextWithRemoteSpanContext(ctx, api.NewSpanContext(cfg))
+}
+
+func (jaegerPropagator) Fields() []string {
+	return []string{jaegerHeader}
+}
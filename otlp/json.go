@@ -0,0 +1,213 @@
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	otlp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// jsonClient implements otlptrace.Client with OTLP/HTTP JSON encoding
+type jsonClient struct {
+	endpoint string
+	compress bool
+	headers  map[string]string
+	retry    otlp.RetryConfig
+	client   *http.Client
+	mu       sync.Mutex
+	stopped  bool
+}
+
+func newJSONClient(cfg *TraceConfig) (*jsonClient, error) {
+	endpoint, err := httpEndpoint(cfg.Endpoint, cfg.Insecure.Valid && cfg.Insecure.Bool, "/v1/traces")
+	if err != nil {
+		return nil, err
+	}
+	c := &jsonClient{
+		endpoint: endpoint,
+		compress: cfg.Compress == "gzip",
+		headers:  cfg.Headers,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+	if cfg.Timeout != 0 {
+		c.client.Timeout = cfg.Timeout
+	}
+	if cfg.Retry != nil {
+		c.retry = *cfg.Retry
+		//!! defaults of the sdk exporters, zero interval would retry without any wait
+		if c.retry.InitialInterval <= 0 {
+			c.retry.InitialInterval = 5 * time.Second
+		}
+		if c.retry.MaxInterval <= 0 {
+			c.retry.MaxInterval = 30 * time.Second
+		}
+		if c.retry.MaxElapsedTime <= 0 {
+			c.retry.MaxElapsedTime = time.Minute
+		}
+	}
+	return c, nil
+}
+
+func (c *jsonClient) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *jsonClient) Stop(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+func (c *jsonClient) UploadTraces(ctx context.Context, spans []*tracepb.ResourceSpans) error {
+	c.mu.Lock()
+	stopped := c.stopped
+	c.mu.Unlock()
+	if stopped {
+		return fmt.Errorf("telemetry.otlp.json client stopped")
+	}
+	body, err := marshalJSON(&coltracepb.ExportTraceServiceRequest{ResourceSpans: spans})
+	if err != nil {
+		return err
+	}
+	if c.compress {
+		buf := new(bytes.Buffer)
+		w := gzip.NewWriter(buf)
+		if _, err = w.Write(body); err != nil {
+			return err
+		}
+		if err = w.Close(); err != nil {
+			return err
+		}
+		body = buf.Bytes()
+	}
+	var (
+		start    = time.Now()
+		interval = c.retry.InitialInterval
+	)
+	for {
+		retryable, after, err := c.send(ctx, body)
+		if err == nil || !retryable || !c.retry.Enabled {
+			return err
+		}
+		if after == 0 {
+			after = interval
+			if interval *= 2; c.retry.MaxInterval != 0 && interval > c.retry.MaxInterval {
+				interval = c.retry.MaxInterval
+			}
+		}
+		if c.retry.MaxElapsedTime != 0 && time.Since(start)+after > c.retry.MaxElapsedTime {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(after):
+		}
+	}
+}
+
+func (c *jsonClient) send(ctx context.Context, body []byte) (retryable bool, after time.Duration, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.compress {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return true, 0, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusAccepted:
+		return false, 0, nil
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if s, e := strconv.Atoi(res.Header.Get("Retry-After")); e == nil {
+			after = time.Duration(s) * time.Second
+		}
+		return true, after, fmt.Errorf("telemetry.otlp.json export failed: %s", res.Status)
+	default:
+		return false, 0, fmt.Errorf("telemetry.otlp.json export failed: %s", res.Status)
+	}
+}
+
+// marshalJSON encode request as OTLP/JSON, which differs from standard protobuf JSON mapping
+// by using integer enums and hex encoded trace and span ids
+func marshalJSON(req *coltracepb.ExportTraceServiceRequest) ([]byte, error) {
+	b, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	var v any
+	if err = json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	hexIds(v)
+	return json.Marshal(v)
+}
+
+func hexIds(v any) {
+	switch x := v.(type) {
+	case map[string]any:
+		for k, e := range x {
+			switch k {
+			case "traceId", "spanId", "parentSpanId":
+				if s, ok := e.(string); ok {
+					if b, err := base64.StdEncoding.DecodeString(s); err == nil {
+						x[k] = hex.EncodeToString(b)
+					}
+				}
+			default:
+				hexIds(e)
+			}
+		}
+	case []any:
+		for _, e := range x {
+			hexIds(e)
+		}
+	}
+}
+
+// httpEndpoint resolve endpoint to a full url, the signal path is appended when endpoint without path
+func httpEndpoint(endpoint string, insecure bool, path string) (string, error) {
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+	if endpoint == "" {
+		endpoint = "localhost:4318"
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		if u, err = url.Parse(scheme + "://" + endpoint); err != nil {
+			return "", fmt.Errorf("telemetry.otlp.endpoint invalid: %w", err)
+		}
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = path
+	}
+	return u.String(), nil
+}
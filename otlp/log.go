@@ -0,0 +1,128 @@
+package otlp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	. "github.com/ZenLiuCN/ote/resource"
+	loggrpc "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	loghttp "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	otlp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/log"
+)
+
+// LogConfig config of otlp logs exporter
+type LogConfig struct {
+	Protocol       string //grpc(default) or http/protobuf
+	Endpoint       string
+	Compress       string
+	Insecure       sql.NullBool
+	Reconnect      time.Duration //grpc only
+	Timeout        time.Duration
+	Retry          *otlp.RetryConfig
+	Headers        map[string]string
+	ExportInterval time.Duration
+	ExportTimeout  time.Duration
+	ExportMaxBatch sql.NullInt32
+	QueueSize      sql.NullInt32
+}
+
+// NewLoggerProvider create LoggerProvider with batched otlp exporter, shares resource with other signals
+func NewLoggerProvider(ctx context.Context, cfg *LogConfig, c *Config) (*log.LoggerProvider, error) {
+	var exporter log.Exporter
+	var err error
+	switch cfg.Protocol {
+	case "", ProtocolGRPC:
+		var opt []loggrpc.Option
+		{
+			if d := cfg.Endpoint; d != "" {
+				opt = append(opt, loggrpc.WithEndpointURL(d))
+			}
+			if d := cfg.Compress; d != "" {
+				opt = append(opt, loggrpc.WithCompressor(d))
+			}
+			if cfg.Insecure.Valid && cfg.Insecure.Bool {
+				opt = append(opt, loggrpc.WithInsecure())
+			}
+			if d := cfg.Reconnect; d != time.Duration(0) {
+				opt = append(opt, loggrpc.WithReconnectionPeriod(d))
+			}
+			if d := cfg.Timeout; d != time.Duration(0) {
+				opt = append(opt, loggrpc.WithTimeout(d))
+			}
+			if d := cfg.Retry; d != nil {
+				opt = append(opt, loggrpc.WithRetry(loggrpc.RetryConfig(*d)))
+			}
+			if d := cfg.Headers; len(d) > 0 {
+				opt = append(opt, loggrpc.WithHeaders(d))
+			}
+		}
+		exporter, err = loggrpc.New(ctx, opt...)
+	case ProtocolHTTP:
+		var opt []loghttp.Option
+		{
+			if d := cfg.Endpoint; d != "" {
+				endpoint, err := httpEndpoint(d, cfg.Insecure.Valid && cfg.Insecure.Bool, "/v1/logs")
+				if err != nil {
+					return nil, err
+				}
+				opt = append(opt, loghttp.WithEndpointURL(endpoint))
+			}
+			switch cfg.Compress {
+			case "gzip":
+				opt = append(opt, loghttp.WithCompression(loghttp.GzipCompression))
+			case "none":
+				opt = append(opt, loghttp.WithCompression(loghttp.NoCompression))
+			}
+			if cfg.Insecure.Valid && cfg.Insecure.Bool {
+				opt = append(opt, loghttp.WithInsecure())
+			}
+			if d := cfg.Timeout; d != time.Duration(0) {
+				opt = append(opt, loghttp.WithTimeout(d))
+			}
+			if d := cfg.Retry; d != nil {
+				opt = append(opt, loghttp.WithRetry(loghttp.RetryConfig(*d)))
+			}
+			if d := cfg.Headers; len(d) > 0 {
+				opt = append(opt, loghttp.WithHeaders(d))
+			}
+		}
+		exporter, err = loghttp.New(ctx, opt...)
+	default:
+		return nil, fmt.Errorf("telemetry.otlp.log.protocol not one of grpc|http/protobuf: %s", cfg.Protocol)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var opts []log.LoggerProviderOption
+	{
+		//!! batch
+		{
+			var batchOpt []log.BatchProcessorOption
+			if cfg.ExportInterval != 0 {
+				batchOpt = append(batchOpt, log.WithExportInterval(cfg.ExportInterval))
+			}
+			if cfg.ExportTimeout != 0 {
+				batchOpt = append(batchOpt, log.WithExportTimeout(cfg.ExportTimeout))
+			}
+			if cfg.ExportMaxBatch.Valid {
+				batchOpt = append(batchOpt, log.WithExportMaxBatchSize(int(cfg.ExportMaxBatch.Int32)))
+			}
+			if cfg.QueueSize.Valid {
+				batchOpt = append(batchOpt, log.WithMaxQueueSize(int(cfg.QueueSize.Int32)))
+			}
+			opts = append(opts, log.WithProcessor(log.NewBatchProcessor(exporter, batchOpt...)))
+		}
+		//!! resource
+		{
+			res, err := ParseResource(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, log.WithResource(res))
+		}
+	}
+	return log.NewLoggerProvider(opts...), nil
+}
@@ -0,0 +1,165 @@
+package otlp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	metricgrpc "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	metrichttp "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	otlp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http/protobuf"
+	ProtocolJSON = "http/json"
+)
+
+// MetricConfig config of otlp metrics exporter, pushed by a periodic reader
+type MetricConfig struct {
+	Protocol      string //grpc(default) or http/protobuf
+	Endpoint      string
+	Compress      string
+	Insecure      sql.NullBool
+	Reconnect     time.Duration //grpc only
+	Timeout       time.Duration
+	Retry         *otlp.RetryConfig
+	Headers       map[string]string
+	Interval      time.Duration //periodic reader interval
+	ExportTimeout time.Duration //periodic reader timeout
+	Temporality   string        //cumulative(default)|delta|lowmemory
+	Aggregation   string        //explicit_bucket_histogram(default)|base2_exponential_bucket_histogram
+}
+
+// NewMetricReader create a periodic reader push to otlp exporter
+func NewMetricReader(ctx context.Context, cfg *MetricConfig) (metric.Reader, error) {
+	temporality, err := temporalitySelector(cfg.Temporality)
+	if err != nil {
+		return nil, err
+	}
+	aggregation, err := aggregationSelector(cfg.Aggregation)
+	if err != nil {
+		return nil, err
+	}
+	var exporter metric.Exporter
+	switch cfg.Protocol {
+	case "", ProtocolGRPC:
+		var opt []metricgrpc.Option
+		{
+			if d := cfg.Endpoint; d != "" {
+				opt = append(opt, metricgrpc.WithEndpointURL(d))
+			}
+			if d := cfg.Compress; d != "" {
+				opt = append(opt, metricgrpc.WithCompressor(d))
+			}
+			if cfg.Insecure.Valid && cfg.Insecure.Bool {
+				opt = append(opt, metricgrpc.WithInsecure())
+			}
+			if d := cfg.Reconnect; d != time.Duration(0) {
+				opt = append(opt, metricgrpc.WithReconnectionPeriod(d))
+			}
+			if d := cfg.Timeout; d != time.Duration(0) {
+				opt = append(opt, metricgrpc.WithTimeout(d))
+			}
+			if d := cfg.Retry; d != nil {
+				opt = append(opt, metricgrpc.WithRetry(metricgrpc.RetryConfig(*d)))
+			}
+			if d := cfg.Headers; len(d) > 0 {
+				opt = append(opt, metricgrpc.WithHeaders(d))
+			}
+			opt = append(opt, metricgrpc.WithTemporalitySelector(temporality), metricgrpc.WithAggregationSelector(aggregation))
+		}
+		exporter, err = metricgrpc.New(ctx, opt...)
+	case ProtocolHTTP:
+		var opt []metrichttp.Option
+		{
+			if d := cfg.Endpoint; d != "" {
+				endpoint, err := httpEndpoint(d, cfg.Insecure.Valid && cfg.Insecure.Bool, "/v1/metrics")
+				if err != nil {
+					return nil, err
+				}
+				opt = append(opt, metrichttp.WithEndpointURL(endpoint))
+			}
+			switch cfg.Compress {
+			case "gzip":
+				opt = append(opt, metrichttp.WithCompression(metrichttp.GzipCompression))
+			case "none":
+				opt = append(opt, metrichttp.WithCompression(metrichttp.NoCompression))
+			}
+			if cfg.Insecure.Valid && cfg.Insecure.Bool {
+				opt = append(opt, metrichttp.WithInsecure())
+			}
+			if d := cfg.Timeout; d != time.Duration(0) {
+				opt = append(opt, metrichttp.WithTimeout(d))
+			}
+			if d := cfg.Retry; d != nil {
+				opt = append(opt, metrichttp.WithRetry(metrichttp.RetryConfig(*d)))
+			}
+			if d := cfg.Headers; len(d) > 0 {
+				opt = append(opt, metrichttp.WithHeaders(d))
+			}
+			opt = append(opt, metrichttp.WithTemporalitySelector(temporality), metrichttp.WithAggregationSelector(aggregation))
+		}
+		exporter, err = metrichttp.New(ctx, opt...)
+	default:
+		return nil, fmt.Errorf("telemetry.otlp.metric.protocol not one of grpc|http/protobuf: %s", cfg.Protocol)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var opts []metric.PeriodicReaderOption
+	if cfg.Interval != 0 {
+		opts = append(opts, metric.WithInterval(cfg.Interval))
+	}
+	if cfg.ExportTimeout != 0 {
+		opts = append(opts, metric.WithTimeout(cfg.ExportTimeout))
+	}
+	return metric.NewPeriodicReader(exporter, opts...), nil
+}
+
+func temporalitySelector(name string) (metric.TemporalitySelector, error) {
+	switch name {
+	case "", "cumulative":
+		return metric.DefaultTemporalitySelector, nil
+	case "delta":
+		return func(k metric.InstrumentKind) metricdata.Temporality {
+			switch k {
+			case metric.InstrumentKindUpDownCounter, metric.InstrumentKindObservableUpDownCounter:
+				return metricdata.CumulativeTemporality
+			default:
+				return metricdata.DeltaTemporality
+			}
+		}, nil
+	case "lowmemory":
+		return func(k metric.InstrumentKind) metricdata.Temporality {
+			switch k {
+			case metric.InstrumentKindCounter, metric.InstrumentKindHistogram:
+				return metricdata.DeltaTemporality
+			default:
+				return metricdata.CumulativeTemporality
+			}
+		}, nil
+	default:
+		return nil, fmt.Errorf("telemetry.otlp.metric.temporality not one of cumulative|delta|lowmemory: %s", name)
+	}
+}
+
+func aggregationSelector(name string) (metric.AggregationSelector, error) {
+	switch name {
+	case "", "explicit_bucket_histogram":
+		return metric.DefaultAggregationSelector, nil
+	case "base2_exponential_bucket_histogram":
+		return func(k metric.InstrumentKind) metric.Aggregation {
+			if k == metric.InstrumentKindHistogram {
+				return metric.AggregationBase2ExponentialHistogram{MaxSize: 160, MaxScale: 20}
+			}
+			return metric.DefaultAggregationSelector(k)
+		}, nil
+	default:
+		return nil, fmt.Errorf("telemetry.otlp.metric.aggregation not one of explicit_bucket_histogram|base2_exponential_bucket_histogram: %s", name)
+	}
+}
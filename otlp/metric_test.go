@@ -0,0 +1,97 @@
+package otlp
+
+import (
+	"context"
+	"database/sql"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTemporalitySelector(t *testing.T) {
+	const cumulative, delta = metricdata.CumulativeTemporality, metricdata.DeltaTemporality
+	kinds := []metric.InstrumentKind{
+		metric.InstrumentKindCounter,
+		metric.InstrumentKindUpDownCounter,
+		metric.InstrumentKindHistogram,
+		metric.InstrumentKindObservableCounter,
+		metric.InstrumentKindObservableUpDownCounter,
+		metric.InstrumentKindObservableGauge,
+	}
+	for name, want := range map[string][]metricdata.Temporality{
+		"":           {cumulative, cumulative, cumulative, cumulative, cumulative, cumulative},
+		"cumulative": {cumulative, cumulative, cumulative, cumulative, cumulative, cumulative},
+		"delta":      {delta, cumulative, delta, delta, cumulative, delta},
+		"lowmemory":  {delta, cumulative, delta, cumulative, cumulative, cumulative},
+	} {
+		s, err := temporalitySelector(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, k := range kinds {
+			if got := s(k); got != want[i] {
+				t.Errorf("%s %s: want %s got %s", name, k, want[i], got)
+			}
+		}
+	}
+	if _, err := temporalitySelector("bad"); err == nil {
+		t.Fatal("expect invalid temporality")
+	}
+}
+
+func TestAggregationSelector(t *testing.T) {
+	s, err := aggregationSelector("base2_exponential_bucket_histogram")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s(metric.InstrumentKindHistogram).(metric.AggregationBase2ExponentialHistogram); !ok {
+		t.Fatalf("histogram %T", s(metric.InstrumentKindHistogram))
+	}
+	if _, ok := s(metric.InstrumentKindCounter).(metric.AggregationSum); !ok {
+		t.Fatalf("counter %T", s(metric.InstrumentKindCounter))
+	}
+	if _, err = aggregationSelector("bad"); err == nil {
+		t.Fatal("expect invalid aggregation")
+	}
+}
+
+func TestHttpMetricExporter(t *testing.T) {
+	c := new(collector)
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+	ctx := context.Background()
+	reader, err := NewMetricReader(ctx, &MetricConfig{
+		Protocol:    ProtocolHTTP,
+		Endpoint:    srv.URL,
+		Insecure:    sql.NullBool{Bool: true, Valid: true},
+		Headers:     map[string]string{"Authorization": "token"},
+		Temporality: "delta",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	counter, _ := mp.Meter("test").Int64Counter("requests")
+	counter.Add(ctx, 3)
+	if err = mp.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	c.Lock()
+	defer c.Unlock()
+	if c.path != "/v1/metrics" || c.header.Get("Authorization") != "token" {
+		t.Fatalf("request %s %v", c.path, c.header)
+	}
+	req := new(colmetricpb.ExportMetricsServiceRequest)
+	if err = proto.Unmarshal(c.request, req); err != nil {
+		t.Fatal(err)
+	}
+	m := req.ResourceMetrics[0].ScopeMetrics[0].Metrics[0]
+	sum := m.GetSum()
+	if m.Name != "requests" || sum == nil || sum.DataPoints[0].GetAsInt() != 3 || sum.AggregationTemporality.String() != "AGGREGATION_TEMPORALITY_DELTA" {
+		t.Fatalf("metric %v", m)
+	}
+}
@@ -0,0 +1,77 @@
+package otlp
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	otTraceID       = "ot-tracer-traceid"
+	otSpanID        = "ot-tracer-spanid"
+	otSampled       = "ot-tracer-sampled"
+	otBaggagePrefix = "ot-baggage-"
+)
+
+// otPropagator opentracing basic tracer propagation, the trace id is injected as its lower 64 bits,
+// baggage members are carried by ot-baggage-* headers.
+type otPropagator struct{}
+
+func (otPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := api.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	carrier.Set(otTraceID, sc.TraceID().String()[16:])
+	carrier.Set(otSpanID, sc.SpanID().String())
+	if sc.IsSampled() {
+		carrier.Set(otSampled, "true")
+	} else {
+		carrier.Set(otSampled, "false")
+	}
+	for _, m := range baggage.FromContext(ctx).Members() {
+		carrier.Set(otBaggagePrefix+m.Key(), m.Value())
+	}
+}
+
+func (otPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	var members []baggage.Member
+	for _, k := range carrier.Keys() {
+		key := strings.ToLower(k)
+		if !strings.HasPrefix(key, otBaggagePrefix) {
+			continue
+		}
+		if m, err := baggage.NewMemberRaw(strings.TrimPrefix(key, otBaggagePrefix), carrier.Get(k)); err == nil {
+			members = append(members, m)
+		}
+	}
+	if len(members) > 0 {
+		if b, err := baggage.New(members...); err == nil {
+			ctx = baggage.ContextWithBaggage(ctx, b)
+		}
+	}
+	traceID, spanID := carrier.Get(otTraceID), carrier.Get(otSpanID)
+	if len(traceID) != 16 && len(traceID) != 32 || len(spanID) != 16 {
+		return ctx
+	}
+	cfg := api.SpanContextConfig{Remote: true}
+	var ok bool
+	if cfg.TraceID, ok = traceIDFromHex(traceID); !ok {
+		return ctx
+	}
+	if cfg.SpanID, ok = spanIDFromHex(spanID); !ok {
+		return ctx
+	}
+	switch carrier.Get(otSampled) {
+	case "true", "1":
+		cfg.TraceFlags = api.FlagsSampled
+	}
+	return api.ContextWithRemoteSpanContext(ctx, api.NewSpanContext(cfg))
+}
+
+func (otPropagator) Fields() []string {
+	return []string{otTraceID, otSpanID, otSampled}
+}
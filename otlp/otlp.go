@@ -3,15 +3,21 @@ package otlp
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"io"
 
 	. "github.com/ZenLiuCN/ote/resource"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	otlp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	otlphttp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/sdk/trace"
 	"log/slog"
 	"time"
 )
 
 type TraceConfig struct {
+	Disabled           sql.NullBool //disable telemetry, SetupTelemetry does nothing
+	Protocol           string       //grpc(default)|http/protobuf|http/json
 	Endpoint           string
 	Compress           string
 	Insecure           sql.NullBool
@@ -25,64 +31,61 @@ type TraceConfig struct {
 	QueueSize          sql.NullInt32
 	QueueBlocking      sql.NullBool
 	Sampler            *SamplerConfig
+	Metric             *MetricConfig //optional otlp metrics push reader
+	Log                *LogConfig    //optional otlp logs exporter
+	Tail               *TailConfig   //optional tail sampling before export
+	Propagators        []string      //tracecontext|baggage|b3|b3multi|jaeger|ottrace|xray|none, default tracecontext,baggage
+	Strict             bool          //refuse to setup when Validate fails, otherwise problems are logged
+	MetricBaggage      []string      //baggage members added to measurements of Telemetry instruments, * for all
 	*Config
 }
 type SamplerConfig struct {
-	Name    string
-	Based   string
-	Ratio   sql.NullFloat64
-	Options []string
+	Name     string
+	Based    string
+	Ratio    sql.NullFloat64
+	Rate     sql.NullFloat64 //ratelimit sampler max traces per second
+	Options  []string
+	Rules    []SamplerRule  //rules sampler, the first matched rule decides
+	Default  *SamplerConfig //rules sampler fallback, default always; remote sampler initial, default ratio 0.001
+	Endpoint string         //remote sampler jaeger strategy url, like http://localhost:5778/sampling
+	Interval time.Duration  //remote sampler polling interval, default 1m
+	Service  string         //remote sampler service, default resource service name
 }
 
+// TraceProvider a TracerProvider whose Shutdown also stops the sampler, like polling of remote sampler
+type TraceProvider struct {
+	*trace.TracerProvider
+	sampler trace.Sampler
+}
+
+// Shutdown stop the sampler and the TracerProvider
+func (p *TraceProvider) Shutdown(ctx context.Context) error {
+	closeSampler(p.sampler)
+	return p.TracerProvider.Shutdown(ctx)
+}
+
+// NewTraceProvider create TracerProvider of cfg, a remote sampler keeps polling for the process lifetime,
+// use NewClosableTraceProvider to stop it on Shutdown
 func NewTraceProvider(ctx context.Context, cfg *TraceConfig) (*trace.TracerProvider, error) {
-	var opt []otlp.Option
-	{
-		opt = append(opt, otlp.WithEndpointURL(cfg.Endpoint))
-		if d := cfg.Compress; d != "" {
-			opt = append(opt, otlp.WithCompressor(d))
-		}
-		if cfg.Insecure.Valid && cfg.Insecure.Bool {
-			opt = append(opt, otlp.WithInsecure())
-		}
-		if d := cfg.Reconnect; d != time.Duration(0) {
-			opt = append(opt, otlp.WithReconnectionPeriod(d))
-		}
-		if d := cfg.Timeout; d != time.Duration(0) {
-			opt = append(opt, otlp.WithTimeout(d))
-		}
-		if d := cfg.Retry; d != nil {
-			opt = append(opt, otlp.WithRetry(*d))
-		}
-		if d := cfg.Headers; len(d) > 0 {
-			opt = append(opt, otlp.WithHeaders(d))
-		}
+	p, err := NewClosableTraceProvider(ctx, cfg)
+	if err != nil {
+		return nil, err
 	}
-	traceExporter, err := otlp.New(ctx, opt...)
+	return p.TracerProvider, nil
+}
+
+// NewClosableTraceProvider create TracerProvider of cfg whose Shutdown also stops the sampler
+func NewClosableTraceProvider(ctx context.Context, cfg *TraceConfig) (*TraceProvider, error) {
+	processor, err := NewSpanProcessor(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
 	var opts []trace.TracerProviderOption
+	var sampler trace.Sampler
 	{
 		//!! batch
 		{
-			var spanOpt []trace.BatchSpanProcessorOption
-			if cfg.ExportTimeout != 0 {
-				spanOpt = append(spanOpt, trace.WithExportTimeout(cfg.ExportTimeout))
-			}
-			if cfg.ExportBatchSize.Valid {
-				spanOpt = append(spanOpt, trace.WithMaxExportBatchSize(int(cfg.ExportBatchSize.Int32)))
-			}
-
-			if cfg.ExportBatchTimeout != 0 {
-				spanOpt = append(spanOpt, trace.WithBatchTimeout(cfg.ExportBatchTimeout))
-			}
-			if cfg.QueueSize.Valid {
-				spanOpt = append(spanOpt, trace.WithMaxQueueSize(int(cfg.QueueSize.Int32)))
-			}
-			if cfg.QueueBlocking.Valid && cfg.QueueBlocking.Bool {
-				spanOpt = append(spanOpt, trace.WithBlocking())
-			}
-			opts = append(opts, trace.WithBatcher(traceExporter, spanOpt...))
+			opts = append(opts, trace.WithSpanProcessor(processor))
 		}
 		//!! resource
 		{
@@ -94,56 +97,211 @@ func NewTraceProvider(ctx context.Context, cfg *TraceConfig) (*trace.TracerProvi
 		}
 		//!! sampler
 		{
-			if sampler := cfg.Sampler; sampler != nil {
-				switch sampler.Name {
-				case "always":
-					opts = append(opts, trace.WithSampler(trace.AlwaysSample()))
-				case "never":
-					opts = append(opts, trace.WithSampler(trace.NeverSample()))
-				case "parent":
-					var options []trace.ParentBasedSamplerOption
-					var sam trace.Sampler
-					switch sampler.Based {
-					case "always":
-						sam = trace.AlwaysSample()
-					case "ratio":
-						ratio := 1.0
-						if sampler.Ratio.Valid {
-							ratio = sampler.Ratio.Float64
-						}
-						sam = trace.TraceIDRatioBased(ratio)
-					case "never":
-						sam = trace.NeverSample()
-					default:
-						slog.Error("telemetry.oltp.trace.sample.based not one of always|never|ratio, will use never as default",
-							"based", sampler.Based,
-						)
-						sam = trace.NeverSample()
-					}
-					for _, s := range sampler.Options {
-						switch s {
-						case "withRemote":
-							options = append(options, trace.WithRemoteParentSampled(sam))
-						case "withoutRemote":
-							options = append(options, trace.WithRemoteParentNotSampled(sam))
-						case "withLocal":
-							options = append(options, trace.WithLocalParentSampled(sam))
-						case "withoutLocal":
-							options = append(options, trace.WithLocalParentNotSampled(sam))
-						default:
-							slog.Warn("unknown options", "name", s)
-						}
-					}
-					opts = append(opts, trace.WithSampler(trace.ParentBased(sam, options...)))
-				default:
-					slog.Error("sampler.name not one of always|never|parent, will use system default",
-						"name", sampler.Name,
-					)
-				}
+			if sampler = newTraceSampler(cfg); sampler != nil {
+				opts = append(opts, trace.WithSampler(sampler))
+			}
+		}
+
+	}
+	return &TraceProvider{TracerProvider: trace.NewTracerProvider(opts...), sampler: sampler}, nil
+}
+
+// NewSpanProcessor create batch span processor with otlp exporter, behind a TailProcessor if Tail configured
+func NewSpanProcessor(ctx context.Context, cfg *TraceConfig) (trace.SpanProcessor, error) {
+	traceExporter, err := newTraceExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	var spanOpt []trace.BatchSpanProcessorOption
+	if cfg.ExportTimeout != 0 {
+		spanOpt = append(spanOpt, trace.WithExportTimeout(cfg.ExportTimeout))
+	}
+	if cfg.ExportBatchSize.Valid {
+		spanOpt = append(spanOpt, trace.WithMaxExportBatchSize(int(cfg.ExportBatchSize.Int32)))
+	}
+
+	if cfg.ExportBatchTimeout != 0 {
+		spanOpt = append(spanOpt, trace.WithBatchTimeout(cfg.ExportBatchTimeout))
+	}
+	if cfg.QueueSize.Valid {
+		spanOpt = append(spanOpt, trace.WithMaxQueueSize(int(cfg.QueueSize.Int32)))
+	}
+	if cfg.QueueBlocking.Valid && cfg.QueueBlocking.Bool {
+		spanOpt = append(spanOpt, trace.WithBlocking())
+	}
+	batch := trace.NewBatchSpanProcessor(traceExporter, spanOpt...)
+	if cfg.Tail != nil {
+		return NewTailProcessor(batch, cfg.Tail), nil
+	}
+	return batch, nil
+}
+
+// NewSampler create sampler from config, returns nil for system default
+func NewSampler(sampler *SamplerConfig) trace.Sampler {
+	if sampler == nil {
+		return nil
+	}
+	switch sampler.Name {
+	case "parent":
+		var options []trace.ParentBasedSamplerOption
+		sam := rootSampler(sampler.Based, sampler)
+		if sam == nil {
+			slog.Error("telemetry.oltp.trace.sample.based not one of always|never|ratio|rules|ratelimit|remote, will use never as default",
+				"based", sampler.Based,
+			)
+			sam = trace.NeverSample()
+		}
+		for _, s := range sampler.Options {
+			switch s {
+			case "withRemote":
+				options = append(options, trace.WithRemoteParentSampled(sam))
+			case "withoutRemote":
+				options = append(options, trace.WithRemoteParentNotSampled(sam))
+			case "withLocal":
+				options = append(options, trace.WithLocalParentSampled(sam))
+			case "withoutLocal":
+				options = append(options, trace.WithLocalParentNotSampled(sam))
+			default:
+				slog.Warn("unknown options", "name", s)
 			}
 		}
+		if c, ok := sam.(io.Closer); ok {
+			return &parentSampler{Sampler: trace.ParentBased(sam, options...), root: c}
+		}
+		return trace.ParentBased(sam, options...)
+	default:
+		sam := rootSampler(sampler.Name, sampler)
+		if sam == nil {
+			slog.Error("sampler.name not one of always|never|ratio|rules|ratelimit|remote|parent, will use system default",
+				"name", sampler.Name,
+			)
+		}
+		return sam
+	}
+}
+
+// newTraceSampler create sampler of cfg, remote sampler polls for service of resource
+func newTraceSampler(cfg *TraceConfig) trace.Sampler {
+	s := cfg.Sampler
+	if s != nil && s.Service == "" && cfg.Config != nil && cfg.Service.Valid {
+		c := *s
+		c.Service = cfg.Service.String
+		s = &c
+	}
+	return NewSampler(s)
+}
+
+// parentSampler keeps the root sampler to close
+type parentSampler struct {
+	trace.Sampler
+	root io.Closer
+}
+
+func (s *parentSampler) Close() error {
+	return s.root.Close()
+}
+
+// closeSampler stop background works of sampler, like polling of remote sampler
+func closeSampler(s trace.Sampler) {
+	if c, ok := s.(io.Closer); ok {
+		_ = c.Close()
+	}
+}
 
+// rootSampler create sampler of name, returns nil for unknown name
+func rootSampler(name string, sampler *SamplerConfig) trace.Sampler {
+	switch name {
+	case "always":
+		return trace.AlwaysSample()
+	case "never":
+		return trace.NeverSample()
+	case "ratio":
+		ratio := 1.0
+		if sampler.Ratio.Valid {
+			ratio = sampler.Ratio.Float64
+		}
+		return trace.TraceIDRatioBased(ratio)
+	case "rules":
+		return newRulesSampler(sampler)
+	case "ratelimit":
+		if !sampler.Rate.Valid || sampler.Rate.Float64 <= 0 {
+			slog.Error("telemetry.otlp.trace.sampler.rate must be positive for ratelimit sampler", "rate", sampler.Rate.Float64)
+			return nil
+		}
+		return newRateLimitSampler(sampler.Rate.Float64, nil)
+	case "remote":
+		s, err := newRemoteSampler(sampler)
+		if err != nil {
+			slog.Error("telemetry.otlp.trace.sampler create remote sampler", "error", err)
+			return nil
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+func newTraceExporter(ctx context.Context, cfg *TraceConfig) (*otlptrace.Exporter, error) {
+	switch cfg.Protocol {
+	case "", ProtocolGRPC:
+		var opt []otlp.Option
+		{
+			opt = append(opt, otlp.WithEndpointURL(cfg.Endpoint))
+			if d := cfg.Compress; d != "" {
+				opt = append(opt, otlp.WithCompressor(d))
+			}
+			if cfg.Insecure.Valid && cfg.Insecure.Bool {
+				opt = append(opt, otlp.WithInsecure())
+			}
+			if d := cfg.Reconnect; d != time.Duration(0) {
+				opt = append(opt, otlp.WithReconnectionPeriod(d))
+			}
+			if d := cfg.Timeout; d != time.Duration(0) {
+				opt = append(opt, otlp.WithTimeout(d))
+			}
+			if d := cfg.Retry; d != nil {
+				opt = append(opt, otlp.WithRetry(*d))
+			}
+			if d := cfg.Headers; len(d) > 0 {
+				opt = append(opt, otlp.WithHeaders(d))
+			}
+		}
+		return otlp.New(ctx, opt...)
+	case ProtocolHTTP:
+		endpoint, err := httpEndpoint(cfg.Endpoint, cfg.Insecure.Valid && cfg.Insecure.Bool, "/v1/traces")
+		if err != nil {
+			return nil, err
+		}
+		var opt []otlphttp.Option
+		{
+			opt = append(opt, otlphttp.WithEndpointURL(endpoint))
+			switch cfg.Compress {
+			case "gzip":
+				opt = append(opt, otlphttp.WithCompression(otlphttp.GzipCompression))
+			case "none":
+				opt = append(opt, otlphttp.WithCompression(otlphttp.NoCompression))
+			}
+			if cfg.Insecure.Valid && cfg.Insecure.Bool {
+				opt = append(opt, otlphttp.WithInsecure())
+			}
+			if d := cfg.Timeout; d != time.Duration(0) {
+				opt = append(opt, otlphttp.WithTimeout(d))
+			}
+			if d := cfg.Retry; d != nil {
+				opt = append(opt, otlphttp.WithRetry(otlphttp.RetryConfig(*d)))
+			}
+			if d := cfg.Headers; len(d) > 0 {
+				opt = append(opt, otlphttp.WithHeaders(d))
+			}
+		}
+		return otlphttp.New(ctx, opt...)
+	case ProtocolJSON:
+		client, err := newJSONClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return otlptrace.New(ctx, client)
+	default:
+		return nil, fmt.Errorf("telemetry.otlp.trace.protocol not one of grpc|http/protobuf|http/json: %s", cfg.Protocol)
 	}
-	traceProvider := trace.NewTracerProvider(opts...)
-	return traceProvider, nil
 }
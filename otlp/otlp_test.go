@@ -0,0 +1,169 @@
+package otlp
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/ZenLiuCN/ote/resource"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type collector struct {
+	sync.Mutex
+	path    string
+	header  http.Header
+	request []byte
+	count   int
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		z, err := gzip.NewReader(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = z
+	}
+	c.count++
+	c.path = r.URL.Path
+	c.header = r.Header.Clone()
+	c.request, _ = io.ReadAll(body)
+	if r.Header.Get("Content-Type") == "application/json" {
+		_, _ = w.Write([]byte("{}"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	b, _ := proto.Marshal(&coltracepb.ExportTraceServiceResponse{})
+	_, _ = w.Write(b)
+}
+
+func exportSpan(t *testing.T, cfg *TraceConfig) {
+	ctx := context.Background()
+	tp, err := NewTraceProvider(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, sp := tp.Tracer("test").Start(ctx, "span")
+	sp.End()
+	if err = tp.ForceFlush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err = tp.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHttpProtobufExporter(t *testing.T) {
+	c := new(collector)
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+	exportSpan(t, &TraceConfig{
+		Protocol: ProtocolHTTP,
+		Endpoint: srv.URL,
+		Compress: "gzip",
+		Insecure: sql.NullBool{Bool: true, Valid: true},
+		Headers:  map[string]string{"Authorization": "token"},
+		Config:   &Config{},
+	})
+	c.Lock()
+	defer c.Unlock()
+	if c.path != "/v1/traces" {
+		t.Fatalf("path %s", c.path)
+	}
+	if c.header.Get("Authorization") != "token" {
+		t.Fatalf("headers %v", c.header)
+	}
+	req := new(coltracepb.ExportTraceServiceRequest)
+	if err := proto.Unmarshal(c.request, req); err != nil {
+		t.Fatal(err)
+	}
+	if n := req.ResourceSpans[0].ScopeSpans[0].Spans[0].Name; n != "span" {
+		t.Fatalf("span name %s", n)
+	}
+}
+
+func TestHttpJsonExporter(t *testing.T) {
+	c := new(collector)
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+	exportSpan(t, &TraceConfig{
+		Protocol: ProtocolJSON,
+		Endpoint: srv.URL + "/custom/traces",
+		Compress: "gzip",
+		Headers:  map[string]string{"Authorization": "token"},
+		Config:   &Config{},
+	})
+	c.Lock()
+	defer c.Unlock()
+	if c.path != "/custom/traces" {
+		t.Fatalf("path %s", c.path)
+	}
+	if c.header.Get("Authorization") != "token" {
+		t.Fatalf("headers %v", c.header)
+	}
+	var req struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceId string `json:"traceId"`
+					SpanId  string `json:"spanId"`
+					Name    string `json:"name"`
+					Kind    int    `json:"kind"`
+				} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	if err := json.Unmarshal(c.request, &req); err != nil {
+		t.Fatal(err)
+	}
+	sp := req.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if sp.Name != "span" || len(sp.TraceId) != 32 || len(sp.SpanId) != 16 || sp.Kind != 1 {
+		t.Fatalf("span %+v", sp)
+	}
+}
+
+func TestHttpJsonExporterRetry(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if requests++; requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+	exportSpan(t, &TraceConfig{
+		Protocol: ProtocolJSON,
+		Endpoint: srv.URL,
+		Retry:    &otlptracegrpc.RetryConfig{Enabled: true, InitialInterval: 10 * time.Millisecond},
+		Config:   &Config{},
+	})
+	mu.Lock()
+	defer mu.Unlock()
+	if requests != 2 {
+		t.Fatalf("requests %d", requests)
+	}
+	//!! unset intervals fallback to sdk defaults
+	c, err := newJSONClient(&TraceConfig{Endpoint: srv.URL, Retry: &otlptracegrpc.RetryConfig{Enabled: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.retry.InitialInterval != 5*time.Second || c.retry.MaxInterval != 30*time.Second || c.retry.MaxElapsedTime != time.Minute {
+		t.Fatalf("retry %+v", c.retry)
+	}
+}
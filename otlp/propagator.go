@@ -0,0 +1,50 @@
+package otlp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// propagators by OTEL_PROPAGATORS names
+var propagators = map[string]propagation.TextMapPropagator{
+	"tracecontext": propagation.TraceContext{},
+	"baggage":      propagation.Baggage{},
+	"b3":           b3Propagator{},
+	"b3multi":      b3Propagator{multi: true},
+	"jaeger":       jaegerPropagator{},
+	"ottrace":      otPropagator{},
+	"xray":         xrayPropagator{},
+	"none":         nil,
+}
+
+// propagatorNames sorted names of propagators
+func propagatorNames() string {
+	names := make([]string, 0, len(propagators))
+	for name := range propagators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, "|")
+}
+
+// NewPropagator create composite propagator from names in order, default to tracecontext and baggage when names is empty.
+// Extraction of later propagators overrides earlier ones when several formats present.
+func NewPropagator(names []string) (propagation.TextMapPropagator, error) {
+	if len(names) == 0 {
+		names = []string{"tracecontext", "baggage"}
+	}
+	var props []propagation.TextMapPropagator
+	for _, name := range names {
+		p, ok := propagators[name]
+		if !ok {
+			return nil, fmt.Errorf("telemetry.propagator not one of %s: %s", propagatorNames(), name)
+		}
+		if p != nil {
+			props = append(props, p)
+		}
+	}
+	return propagation.NewCompositeTextMapPropagator(props...), nil
+}
@@ -0,0 +1,143 @@
+package otlp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+func TestPropagators(t *testing.T) {
+	sc := api.NewSpanContext(api.SpanContextConfig{
+		TraceID:    api.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     api.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: api.FlagsSampled,
+	})
+	ctx := api.ContextWithSpanContext(context.Background(), sc)
+	for name, header := range map[string]string{
+		"tracecontext": "Traceparent",
+		"b3":           "B3",
+		"b3multi":      "X-B3-Traceid",
+		"jaeger":       "Uber-Trace-Id",
+		"ottrace":      "Ot-Tracer-Traceid",
+		"xray":         "X-Amzn-Trace-Id",
+	} {
+		p, err := NewPropagator([]string{name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := http.Header{}
+		p.Inject(ctx, propagation.HeaderCarrier(h))
+		if h.Get(header) == "" {
+			t.Errorf("%s: missing %s in %v", name, header, h)
+			continue
+		}
+		got := api.SpanContextFromContext(p.Extract(context.Background(), propagation.HeaderCarrier(h)))
+		want := sc.TraceID()
+		if name == "ottrace" { //64 bits trace id
+			copy(want[:8], make([]byte, 8))
+		}
+		if got.TraceID() != want || got.SpanID() != sc.SpanID() || !got.IsSampled() {
+			t.Errorf("%s: extracted %v", name, got)
+		}
+	}
+	if p, err := NewPropagator([]string{"none"}); err != nil || len(p.Fields()) != 0 {
+		t.Fatalf("none propagator %v %v", p, err)
+	}
+	if _, err := NewPropagator([]string{"zipkin"}); err == nil {
+		t.Fatal("expect unknown propagator")
+	}
+}
+
+func TestPropagatorsFromEnv(t *testing.T) {
+	t.Setenv("OTEL_PROPAGATORS", "b3multi, jaeger")
+	c := new(TraceConfig)
+	if err := c.FromEnv(); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Propagators) != 2 || c.Propagators[0] != "b3multi" || c.Propagators[1] != "jaeger" {
+		t.Fatalf("propagators %v", c.Propagators)
+	}
+	t.Setenv("OTEL_PROPAGATORS", "b3,zipkin")
+	if err := new(TraceConfig).FromEnv(); err == nil {
+		t.Fatal("expect unknown propagator")
+	}
+}
+
+func TestPropagatorFormats(t *testing.T) {
+	const traceID, spanID = "4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7"
+	for _, c := range []struct {
+		name    string
+		headers map[string]string
+		trace   string //empty for no span context
+		sampled bool
+	}{
+		{"b3", map[string]string{"b3": traceID + "-" + spanID + "-d"}, traceID, true},
+		{"b3", map[string]string{"b3": "a3ce929d0e0e4736-" + spanID + "-0-" + spanID}, "0000000000000000a3ce929d0e0e4736", false},
+		{"b3", map[string]string{"b3": "1"}, "", false},
+		{"b3", map[string]string{"b3": traceID + "-" + spanID + "-x"}, "", false},
+		{"b3", map[string]string{"x-b3-traceid": traceID, "x-b3-spanid": spanID, "x-b3-flags": "1"}, traceID, true},
+		{"b3multi", map[string]string{"x-b3-traceid": traceID, "x-b3-spanid": spanID, "x-b3-sampled": "true"}, traceID, true},
+		{"b3multi", map[string]string{"x-b3-traceid": traceID[1:], "x-b3-spanid": spanID}, "", false},
+		{"jaeger", map[string]string{"uber-trace-id": "a3ce929d0e0e4736%3Af067aa0ba902b7%3A0%3A3"}, "0000000000000000a3ce929d0e0e4736", true},
+		{"jaeger", map[string]string{"uber-trace-id": traceID + ":" + spanID + ":0:0"}, traceID, false},
+		{"jaeger", map[string]string{"uber-trace-id": traceID + ":" + spanID + ":0"}, "", false},
+		{"ottrace", map[string]string{"ot-tracer-traceid": traceID, "ot-tracer-spanid": spanID, "ot-tracer-sampled": "false"}, traceID, false},
+		{"ottrace", map[string]string{"ot-tracer-traceid": traceID, "ot-tracer-spanid": spanID[2:]}, "", false},
+		{"xray", map[string]string{"X-Amzn-Trace-Id": "Root=1-4bf92f35-77b34da6a3ce929d0e0e4736;Parent=" + spanID + ";Sampled=1;Self=1"}, traceID, true},
+		{"xray", map[string]string{"X-Amzn-Trace-Id": "Root=1-4bf92f35-77b34da6a3ce929d0e0e4736;Parent=" + spanID + ";Sampled=?"}, traceID, false},
+		{"xray", map[string]string{"X-Amzn-Trace-Id": "Root=2-4bf92f35-77b34da6a3ce929d0e0e4736;Parent=" + spanID}, "", false},
+		{"xray", map[string]string{"X-Amzn-Trace-Id": "Root=1-4bf92f35-77b34da6a3ce929d0e0e4736"}, "", false},
+	} {
+		p, _ := NewPropagator([]string{c.name})
+		sc := api.SpanContextFromContext(p.Extract(context.Background(), propagation.MapCarrier(c.headers)))
+		switch {
+		case c.trace == "" && sc.IsValid():
+			t.Errorf("%s %v: expect no span context, got %v", c.name, c.headers, sc)
+		case c.trace == "":
+		case sc.TraceID().String() != c.trace || !sc.IsRemote() || sc.IsSampled() != c.sampled:
+			t.Errorf("%s %v: extracted %s sampled %t", c.name, c.headers, sc.TraceID(), sc.IsSampled())
+		}
+	}
+}
+
+func TestPropagatorInjectFormats(t *testing.T) {
+	sc := api.NewSpanContext(api.SpanContextConfig{
+		TraceID: api.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:  api.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	})
+	m, _ := baggage.NewMember("user", "alice")
+	b, _ := baggage.New(m)
+	ctx := baggage.ContextWithBaggage(api.ContextWithSpanContext(context.Background(), sc), b)
+	for name, want := range map[string]map[string]string{
+		"b3":      {"b3": "4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-0"},
+		"b3multi": {"x-b3-traceid": "4bf92f3577b34da6a3ce929d0e0e4736", "x-b3-spanid": "00f067aa0ba902b7", "x-b3-sampled": "0"},
+		"jaeger":  {"uber-trace-id": "4bf92f3577b34da6a3ce929d0e0e4736:00f067aa0ba902b7:0:0"},
+		"ottrace": {"ot-tracer-traceid": "a3ce929d0e0e4736", "ot-tracer-spanid": "00f067aa0ba902b7", "ot-tracer-sampled": "false", "ot-baggage-user": "alice"},
+		"xray":    {"X-Amzn-Trace-Id": "Root=1-4bf92f35-77b34da6a3ce929d0e0e4736;Parent=00f067aa0ba902b7;Sampled=0"},
+	} {
+		p, _ := NewPropagator([]string{name})
+		got := propagation.MapCarrier{}
+		p.Inject(ctx, got)
+		if len(got) != len(want) {
+			t.Errorf("%s: injected %v", name, got)
+		}
+		for k, v := range want {
+			if got[k] != v {
+				t.Errorf("%s: %s want %q got %q", name, k, v, got[k])
+			}
+		}
+		if name == "ottrace" {
+			if v := baggage.FromContext(p.Extract(context.Background(), got)).Member("user").Value(); v != "alice" {
+				t.Errorf("ottrace baggage %q", v)
+			}
+		}
+		empty := propagation.MapCarrier{}
+		if p.Inject(context.Background(), empty); len(empty) != 0 {
+			t.Errorf("%s: injected invalid span context %v", name, empty)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package otlp
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+// rateLimitSampler admits at most rate traces per second by a token bucket holding max(rate,1) tokens,
+// only root spans take tokens, others follow the sampled flag of parent.
+// Sampled spans carry sampler.type and sampler.param as jaeger does, so backends can re-weight counts.
+type rateLimitSampler struct {
+	rate  float64
+	max   float64
+	now   func() time.Time
+	mu    sync.Mutex
+	token float64
+	last  time.Time
+	attrs []attribute.KeyValue
+}
+
+func newRateLimitSampler(rate float64, now func() time.Time) *rateLimitSampler {
+	if now == nil {
+		now = time.Now
+	}
+	s := &rateLimitSampler{
+		rate: rate,
+		max:  max(rate, 1),
+		now:  now,
+		attrs: []attribute.KeyValue{
+			attribute.String("sampler.type", "ratelimiting"),
+			attribute.Float64("sampler.param", rate),
+		},
+	}
+	s.token = s.max
+	s.last = now()
+	return s
+}
+
+// take one token if available
+func (s *rateLimitSampler) take() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if d := now.Sub(s.last); d > 0 {
+		s.token = min(s.max, s.token+d.Seconds()*s.rate)
+		s.last = now
+	}
+	if s.token < 1 {
+		return false
+	}
+	s.token--
+	return true
+}
+
+func (s *rateLimitSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	parent := api.SpanContextFromContext(p.ParentContext)
+	ts := parent.TraceState()
+	if parent.IsValid() {
+		if parent.IsSampled() {
+			return trace.SamplingResult{Decision: trace.RecordAndSample, Tracestate: ts}
+		}
+		return trace.SamplingResult{Decision: trace.Drop, Tracestate: ts}
+	}
+	if !s.take() {
+		return trace.SamplingResult{Decision: trace.Drop, Tracestate: ts}
+	}
+	return trace.SamplingResult{Decision: trace.RecordAndSample, Attributes: s.attrs, Tracestate: ts}
+}
+
+func (s *rateLimitSampler) Description() string {
+	return fmt.Sprintf("RateLimitSampler{%g}", s.rate)
+}
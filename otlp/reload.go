@@ -0,0 +1,219 @@
+package otlp
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/ZenLiuCN/ote/resource"
+	"go.opentelemetry.io/otel/sdk/trace"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+// ReloadableTraceProvider a TracerProvider whose sampler and exporter can be replaced at runtime,
+// resource is fixed at creation.
+type ReloadableTraceProvider struct {
+	*trace.TracerProvider
+	sampler   *swapSampler
+	mu        sync.Mutex
+	processor *swapProcessor
+}
+
+func NewReloadableTraceProvider(ctx context.Context, cfg *TraceConfig) (*ReloadableTraceProvider, error) {
+	processor, err := NewSpanProcessor(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	res, err := ParseResource(ctx, cfg.Config)
+	if err != nil {
+		return nil, err
+	}
+	p := &ReloadableTraceProvider{
+		sampler:   newSwapSampler(newTraceSampler(cfg)),
+		processor: newSwapProcessor(processor),
+	}
+	p.TracerProvider = trace.NewTracerProvider(
+		trace.WithSpanProcessor(p.processor),
+		trace.WithResource(res),
+		trace.WithSampler(p.sampler),
+	)
+	return p, nil
+}
+
+// Reload replace sampler and exporter with cfg. The new exporter receives spans started after Reload,
+// spans started before keep going to the old exporter, which is shutdown in background once they all ended
+// or after reloadDrain. On error the current pipeline is kept.
+func (p *ReloadableTraceProvider) Reload(ctx context.Context, cfg *TraceConfig) error {
+	processor, err := NewSpanProcessor(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	closeSampler(p.sampler.store(newTraceSampler(cfg)))
+	//!! a span ends in the processor which saw its start
+	go p.processor.retire(p.processor.store(processor))
+	return nil
+}
+
+// Shutdown stop the sampler and the TracerProvider
+func (p *ReloadableTraceProvider) Shutdown(ctx context.Context) error {
+	closeSampler(*p.sampler.v.Load())
+	return p.TracerProvider.Shutdown(ctx)
+}
+
+// swapSampler delegate to a replaceable sampler
+type swapSampler struct {
+	v atomic.Pointer[trace.Sampler]
+}
+
+func newSwapSampler(s trace.Sampler) *swapSampler {
+	w := new(swapSampler)
+	w.store(s)
+	return w
+}
+
+// store sampler and returns the previous, nil means the sdk default parent based always sampler
+func (w *swapSampler) store(s trace.Sampler) trace.Sampler {
+	if s == nil {
+		s = trace.ParentBased(trace.AlwaysSample())
+	}
+	if old := w.v.Swap(&s); old != nil {
+		return *old
+	}
+	return nil
+}
+
+func (w *swapSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	return (*w.v.Load()).ShouldSample(p)
+}
+
+func (w *swapSampler) Description() string {
+	return (*w.v.Load()).Description()
+}
+
+// reloadDrain max wait of spans started before Reload, spans ending later are dropped by the old exporter
+var reloadDrain = time.Minute
+
+// generation of span processor, counts the spans it started
+type generation struct {
+	trace.SpanProcessor
+	inflight sync.WaitGroup
+	once     sync.Once
+}
+
+func (g *generation) shutdown(ctx context.Context) (err error) {
+	g.once.Do(func() { err = g.SpanProcessor.Shutdown(ctx) })
+	return
+}
+
+type spanKey struct {
+	trace api.TraceID
+	span  api.SpanID
+}
+
+// swapProcessor delegate to a replaceable span processor, each span ends in the processor which saw its start
+type swapProcessor struct {
+	mu      sync.RWMutex //OnStart binds under read lock, so no span joins a replaced generation
+	current *generation
+	retired map[*generation]struct{}
+	spans   sync.Map //spanKey to *generation
+}
+
+func newSwapProcessor(p trace.SpanProcessor) *swapProcessor {
+	w := &swapProcessor{retired: make(map[*generation]struct{})}
+	w.store(p)
+	return w
+}
+
+// store processor and returns the previous generation
+func (w *swapProcessor) store(p trace.SpanProcessor) *generation {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	old := w.current
+	w.current = &generation{SpanProcessor: p}
+	if old != nil {
+		w.retired[old] = struct{}{}
+	}
+	return old
+}
+
+// retire shutdown g after its spans ended or reloadDrain passed
+func (w *swapProcessor) retire(g *generation) {
+	done := make(chan struct{})
+	go func() {
+		g.inflight.Wait()
+		close(done)
+	}()
+	t := time.NewTimer(reloadDrain)
+	defer t.Stop()
+	select {
+	case <-done:
+	case <-t.C:
+		slog.Warn("telemetry.otlp.trace reload spans still running, shutdown old exporter", "wait", reloadDrain)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := g.shutdown(ctx); err != nil {
+		slog.Warn("telemetry.otlp.trace reload shutdown old exporter failed", "error", err)
+	}
+	w.mu.Lock()
+	delete(w.retired, g)
+	w.mu.Unlock()
+}
+
+// all generations, the current first
+func (w *swapProcessor) all() []*generation {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	gs := []*generation{w.current}
+	for g := range w.retired {
+		gs = append(gs, g)
+	}
+	return gs
+}
+
+func (w *swapProcessor) OnStart(parent context.Context, s trace.ReadWriteSpan) {
+	w.mu.RLock()
+	g := w.current
+	g.inflight.Add(1)
+	w.mu.RUnlock()
+	sc := s.SpanContext()
+	w.spans.Store(spanKey{trace: sc.TraceID(), span: sc.SpanID()}, g)
+	g.OnStart(parent, s)
+}
+
+func (w *swapProcessor) OnEnd(s trace.ReadOnlySpan) {
+	sc := s.SpanContext()
+	v, ok := w.spans.LoadAndDelete(spanKey{trace: sc.TraceID(), span: sc.SpanID()})
+	if !ok {
+		w.mu.RLock()
+		g := w.current
+		w.mu.RUnlock()
+		g.OnEnd(s)
+		return
+	}
+	g := v.(*generation)
+	g.OnEnd(s)
+	g.inflight.Done()
+}
+
+// Shutdown the current and retired processors without waiting running spans
+func (w *swapProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	for _, g := range w.all() {
+		err = errors.Join(err, g.shutdown(ctx))
+	}
+	return err
+}
+
+func (w *swapProcessor) ForceFlush(ctx context.Context) error {
+	var err error
+	for _, g := range w.all() {
+		err = errors.Join(err, g.ForceFlush(ctx))
+	}
+	return err
+}
@@ -0,0 +1,227 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// remoteSampler polls jaeger sampling strategies of a service, the last good strategy is kept
+// when the endpoint is unreachable, before the first success the initial sampler decides.
+type remoteSampler struct {
+	url      string
+	interval time.Duration
+	client   *http.Client
+	initial  trace.Sampler
+	current  atomic.Pointer[trace.Sampler]
+	mu       sync.Mutex
+	last     []byte //raw strategy of current, unchanged strategy keeps rate limiter state
+	stop     context.CancelFunc
+	once     sync.Once
+}
+
+// newRemoteSampler start polling in background, stopped by Close
+func newRemoteSampler(cfg *SamplerConfig) (*remoteSampler, error) {
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("telemetry.otlp.trace.sampler.endpoint invalid: %s", cfg.Endpoint)
+	}
+	if q := u.Query(); q.Get("service") == "" && cfg.Service != "" {
+		q.Set("service", cfg.Service)
+		u.RawQuery = q.Encode()
+	}
+	s := &remoteSampler{
+		url:      u.String(),
+		interval: cfg.Interval,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		initial:  NewSampler(cfg.Default),
+	}
+	if s.interval <= 0 {
+		s.interval = time.Minute
+	}
+	if s.initial == nil {
+		s.initial = trace.TraceIDRatioBased(0.001)
+	}
+	s.current.Store(&s.initial)
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stop = cancel
+	go s.poll(ctx)
+	return s, nil
+}
+
+func (s *remoteSampler) poll(ctx context.Context) {
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		if err := s.fetch(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("telemetry.otlp.trace.sampler.remote fetch strategy failed, keep current", "url", s.url, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// fetch strategy and replace current sampler on success
+func (s *remoteSampler) fetch(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %s", res.Status)
+	}
+	if bytes.Equal(body, s.last) {
+		return nil
+	}
+	var st strategy
+	if err = json.Unmarshal(body, &st); err != nil {
+		return err
+	}
+	sampler, err := st.sampler()
+	if err != nil {
+		return err
+	}
+	s.last = body
+	s.current.Store(&sampler)
+	return nil
+}
+
+func (s *remoteSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	return (*s.current.Load()).ShouldSample(p)
+}
+
+func (s *remoteSampler) Description() string {
+	return fmt.Sprintf("RemoteSampler{%s}", (*s.current.Load()).Description())
+}
+
+// Close stop polling
+func (s *remoteSampler) Close() error {
+	s.once.Do(func() {
+		s.stop()
+		closeSampler(s.initial)
+	})
+	return nil
+}
+
+// strategy jaeger sampling strategy response
+type strategy struct {
+	StrategyType          strategyType `json:"strategyType"`
+	ProbabilisticSampling *struct {
+		SamplingRate float64 `json:"samplingRate"`
+	} `json:"probabilisticSampling"`
+	RateLimitingSampling *struct {
+		MaxTracesPerSecond float64 `json:"maxTracesPerSecond"`
+	} `json:"rateLimitingSampling"`
+	OperationSampling *struct {
+		DefaultSamplingProbability       float64 `json:"defaultSamplingProbability"`
+		DefaultLowerBoundTracesPerSecond float64 `json:"defaultLowerBoundTracesPerSecond"`
+		PerOperationStrategies           []struct {
+			Operation             string `json:"operation"`
+			ProbabilisticSampling struct {
+				SamplingRate float64 `json:"samplingRate"`
+			} `json:"probabilisticSampling"`
+		} `json:"perOperationStrategies"`
+	} `json:"operationSampling"`
+}
+
+// strategyType accepts both enum name and number
+type strategyType string
+
+func (t *strategyType) UnmarshalJSON(b []byte) error {
+	switch strings.Trim(string(b), `"`) {
+	case "0", "PROBABILISTIC":
+		*t = "PROBABILISTIC"
+	case "1", "RATE_LIMITING":
+		*t = "RATE_LIMITING"
+	default:
+		return fmt.Errorf("unknown strategy type %s", b)
+	}
+	return nil
+}
+
+func (st *strategy) sampler() (trace.Sampler, error) {
+	switch {
+	case st.OperationSampling != nil:
+		o := st.OperationSampling
+		s := &operationSampler{
+			operations: make(map[string]trace.Sampler, len(o.PerOperationStrategies)),
+			fallback:   lowerBound(o.DefaultSamplingProbability, o.DefaultLowerBoundTracesPerSecond),
+		}
+		for _, p := range o.PerOperationStrategies {
+			s.operations[p.Operation] = lowerBound(p.ProbabilisticSampling.SamplingRate, o.DefaultLowerBoundTracesPerSecond)
+		}
+		return s, nil
+	case st.StrategyType == "RATE_LIMITING" && st.RateLimitingSampling != nil:
+		return newRateLimitSampler(st.RateLimitingSampling.MaxTracesPerSecond, nil), nil
+	case st.ProbabilisticSampling != nil:
+		return trace.TraceIDRatioBased(st.ProbabilisticSampling.SamplingRate), nil
+	default:
+		return nil, fmt.Errorf("empty strategy")
+	}
+}
+
+// operationSampler per span name sampler
+type operationSampler struct {
+	operations map[string]trace.Sampler
+	fallback   trace.Sampler
+}
+
+func (s *operationSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	if x, ok := s.operations[p.Name]; ok {
+		return x.ShouldSample(p)
+	}
+	return s.fallback.ShouldSample(p)
+}
+
+func (s *operationSampler) Description() string {
+	return fmt.Sprintf("OperationSampler{operations:%d,default:%s}", len(s.operations), s.fallback.Description())
+}
+
+// lowerBound probabilistic sampler which also admits up to rate traces per second
+func lowerBound(ratio, rate float64) trace.Sampler {
+	if rate <= 0 {
+		return trace.TraceIDRatioBased(ratio)
+	}
+	return &guaranteedSampler{ratio: trace.TraceIDRatioBased(ratio), limit: newRateLimitSampler(rate, nil)}
+}
+
+type guaranteedSampler struct {
+	ratio trace.Sampler
+	limit *rateLimitSampler
+}
+
+func (s *guaranteedSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	if r := s.ratio.ShouldSample(p); r.Decision == trace.RecordAndSample {
+		return r
+	}
+	return s.limit.ShouldSample(p)
+}
+
+func (s *guaranteedSampler) Description() string {
+	return fmt.Sprintf("GuaranteedThroughputSampler{%s,%s}", s.ratio.Description(), s.limit.Description())
+}
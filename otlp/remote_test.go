@@ -0,0 +1,123 @@
+package otlp
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/ZenLiuCN/ote/resource"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+func TestRemoteSampler(t *testing.T) {
+	var body atomic.Value
+	body.Store("")
+	var service atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		service.Store(r.URL.Query().Get("service"))
+		b := body.Load().(string)
+		if b == "" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(b))
+	}))
+	defer srv.Close()
+	s, err := newRemoteSampler(&SamplerConfig{
+		Endpoint: srv.URL + "/sampling",
+		Interval: time.Hour,
+		Service:  "demo",
+		Default:  &SamplerConfig{Name: "never"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = s.Close() }()
+	ctx := context.Background()
+	if err = s.fetch(ctx); err == nil {
+		t.Fatal("expect unavailable")
+	}
+	if sample(s, ctx, "op", api.SpanKindServer) {
+		t.Fatal("initial sampler should decide before first strategy")
+	}
+	if v, _ := service.Load().(string); v != "demo" {
+		t.Fatalf("service %q", v)
+	}
+	body.Store(`{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":1}}`)
+	if err = s.fetch(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !sample(s, ctx, "op", api.SpanKindServer) {
+		t.Fatal("probabilistic strategy should sample")
+	}
+	body.Store("")
+	if err = s.fetch(ctx); err == nil || !sample(s, ctx, "op", api.SpanKindServer) {
+		t.Fatal("last good strategy should be kept")
+	}
+	body.Store(`{"strategyType":1,"rateLimitingSampling":{"maxTracesPerSecond":1}}`)
+	if err = s.fetch(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !sample(s, ctx, "op", api.SpanKindServer) || sample(s, ctx, "op", api.SpanKindServer) {
+		t.Fatal("rate limiting strategy should admit one trace")
+	}
+	body.Store(`{"strategyType":0,"operationSampling":{"defaultSamplingProbability":1,"perOperationStrategies":[
+		{"operation":"GET /healthz","probabilisticSampling":{"samplingRate":0}}]}}`)
+	if err = s.fetch(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if sample(s, ctx, "GET /healthz", api.SpanKindServer) || !sample(s, ctx, "GET /users", api.SpanKindServer) {
+		t.Fatal("per operation strategy")
+	}
+	if d := s.Description(); !strings.HasPrefix(d, "RemoteSampler{OperationSampler") {
+		t.Fatal(d)
+	}
+}
+
+func TestRemoteSamplerUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	s := NewSampler(&SamplerConfig{Name: "parent", Based: "remote", Endpoint: srv.URL, Default: &SamplerConfig{Name: "always"}})
+	defer closeSampler(s)
+	if _, ok := s.(*parentSampler); !ok {
+		t.Fatalf("parent remote sampler should be closable: %T", s)
+	}
+	if !sample(s, context.Background(), "op", api.SpanKindServer) {
+		t.Fatal("initial sampler should decide")
+	}
+}
+
+func TestTraceProviderShutdownStopsRemoteSampler(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	tp, err := NewClosableTraceProvider(context.Background(), &TraceConfig{
+		Protocol: ProtocolHTTP,
+		Endpoint: srv.URL,
+		Insecure: sql.NullBool{Bool: true, Valid: true},
+		Sampler:  &SamplerConfig{Name: "remote", Endpoint: srv.URL, Interval: 10 * time.Millisecond},
+		Config:   &Config{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for polls.Load() == 0 {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if err = tp.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	n := polls.Load()
+	time.Sleep(100 * time.Millisecond)
+	if polls.Load() > n+1 {
+		t.Fatalf("sampler still polling after shutdown: %d > %d", polls.Load(), n)
+	}
+}
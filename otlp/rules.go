@@ -0,0 +1,151 @@
+package otlp
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+// SamplerRule matches spans by name, kind and attributes, empty conditions match any span.
+// Only attributes given at span start are visible to samplers.
+type SamplerRule struct {
+	Name       string            //span name glob, * matches any sequence and ? matches one character
+	NameRegex  string            //span name regular expression
+	Kind       string            //internal|server|client|producer|consumer
+	Attributes map[string]string //attribute value globs, all must match, * requires presence only
+	Sampler    *SamplerConfig    //sampler of matched spans
+}
+
+var spanKinds = map[string]api.SpanKind{
+	"internal": api.SpanKindInternal,
+	"server":   api.SpanKindServer,
+	"client":   api.SpanKindClient,
+	"producer": api.SpanKindProducer,
+	"consumer": api.SpanKindConsumer,
+}
+
+type rule struct {
+	name    string
+	regex   *regexp.Regexp
+	kind    api.SpanKind
+	attrs   map[string]string
+	sampler trace.Sampler
+}
+
+func (r *rule) match(p trace.SamplingParameters) bool {
+	if r.name != "" && !glob(r.name, p.Name) {
+		return false
+	}
+	if r.regex != nil && !r.regex.MatchString(p.Name) {
+		return false
+	}
+	if r.kind != api.SpanKindUnspecified && r.kind != p.Kind {
+		return false
+	}
+	for k, pattern := range r.attrs {
+		found := false
+		for _, kv := range p.Attributes {
+			if string(kv.Key) == k {
+				found = glob(pattern, kv.Value.Emit())
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// rulesSampler the first matched rule decides, otherwise the fallback
+type rulesSampler struct {
+	rules    []*rule
+	fallback trace.Sampler
+}
+
+// newRulesSampler invalid rules are logged and skipped, fallback defaults to always
+func newRulesSampler(cfg *SamplerConfig) trace.Sampler {
+	s := &rulesSampler{fallback: NewSampler(cfg.Default)}
+	if s.fallback == nil {
+		s.fallback = trace.AlwaysSample()
+	}
+	for i, r := range cfg.Rules {
+		x := &rule{name: r.Name, attrs: r.Attributes}
+		if r.NameRegex != "" {
+			var err error
+			if x.regex, err = regexp.Compile(r.NameRegex); err != nil {
+				slog.Error("telemetry.otlp.trace.sampler.rules invalid name regex, rule skipped", "rule", i, "error", err)
+				continue
+			}
+		}
+		if r.Kind != "" {
+			var ok bool
+			if x.kind, ok = spanKinds[r.Kind]; !ok {
+				slog.Error("telemetry.otlp.trace.sampler.rules unknown kind, rule skipped", "rule", i, "kind", r.Kind)
+				continue
+			}
+		}
+		if x.sampler = NewSampler(r.Sampler); x.sampler == nil {
+			slog.Error("telemetry.otlp.trace.sampler.rules missing sampler, rule skipped", "rule", i)
+			continue
+		}
+		s.rules = append(s.rules, x)
+	}
+	return s
+}
+
+func (s *rulesSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	for _, r := range s.rules {
+		if r.match(p) {
+			return r.sampler.ShouldSample(p)
+		}
+	}
+	return s.fallback.ShouldSample(p)
+}
+
+func (s *rulesSampler) Description() string {
+	b := new(strings.Builder)
+	b.WriteString("RulesSampler{")
+	for _, r := range s.rules {
+		_, _ = fmt.Fprintf(b, "%s,", r.sampler.Description())
+	}
+	_, _ = fmt.Fprintf(b, "default:%s}", s.fallback.Description())
+	return b.String()
+}
+
+func (s *rulesSampler) Close() error {
+	for _, r := range s.rules {
+		closeSampler(r.sampler)
+	}
+	closeSampler(s.fallback)
+	return nil
+}
+
+// glob match s with pattern, * matches any sequence and ? matches one character
+func glob(pattern, s string) bool {
+	px, sx := 0, 0
+	star, next := -1, 0
+	for sx < len(s) {
+		switch {
+		case px < len(pattern) && (pattern[px] == '?' || pattern[px] == s[sx]):
+			px++
+			sx++
+		case px < len(pattern) && pattern[px] == '*':
+			star, next = px, sx
+			px++
+		case star >= 0:
+			next++
+			px, sx = star+1, next
+		default:
+			return false
+		}
+	}
+	for px < len(pattern) && pattern[px] == '*' {
+		px++
+	}
+	return px == len(pattern)
+}
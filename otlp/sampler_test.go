@@ -0,0 +1,160 @@
+package otlp
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+func sample(s trace.Sampler, ctx context.Context, name string, kind api.SpanKind, attrs ...attribute.KeyValue) bool {
+	return s.ShouldSample(trace.SamplingParameters{
+		ParentContext: ctx,
+		TraceID:       api.TraceID{1},
+		Name:          name,
+		Kind:          kind,
+		Attributes:    attrs,
+	}).Decision == trace.RecordAndSample
+}
+
+func TestRulesSampler(t *testing.T) {
+	s := NewSampler(&SamplerConfig{
+		Name: "rules",
+		Rules: []SamplerRule{
+			{Attributes: map[string]string{"http.route": "/healthz"}, Sampler: &SamplerConfig{Name: "never"}},
+			{Attributes: map[string]string{"db.system": "*"}, Sampler: &SamplerConfig{Name: "ratio", Ratio: sql.NullFloat64{Valid: true}}},
+			{Name: "GET /api/*", Kind: "server", Sampler: &SamplerConfig{Name: "always"}},
+			{NameRegex: "^batch\\.", Sampler: &SamplerConfig{Name: "never"}},
+			{NameRegex: "(", Sampler: &SamplerConfig{Name: "always"}}, //invalid, skipped
+		},
+		Default: &SamplerConfig{Name: "never"},
+	})
+	ctx := context.Background()
+	cases := []struct {
+		name  string
+		kind  api.SpanKind
+		attrs []attribute.KeyValue
+		want  bool
+	}{
+		{"GET /healthz", api.SpanKindServer, []attribute.KeyValue{attribute.String("http.route", "/healthz")}, false},
+		{"query", api.SpanKindClient, []attribute.KeyValue{attribute.String("db.system", "mysql")}, false},
+		{"GET /api/users", api.SpanKindServer, nil, true},
+		{"GET /api/users", api.SpanKindClient, nil, false},
+		{"batch.flush", api.SpanKindInternal, nil, false},
+		{"other", api.SpanKindInternal, nil, false},
+	}
+	for _, c := range cases {
+		if got := sample(s, ctx, c.name, c.kind, c.attrs...); got != c.want {
+			t.Errorf("%s(%s): want %v got %v", c.name, c.kind, c.want, got)
+		}
+	}
+	if got := sample(NewSampler(&SamplerConfig{Name: "rules"}), ctx, "other", api.SpanKindInternal); !got {
+		t.Error("rules without default should sample")
+	}
+}
+
+func TestRulesSamplerParent(t *testing.T) {
+	s := NewSampler(&SamplerConfig{
+		Name:  "parent",
+		Based: "rules",
+		Rules: []SamplerRule{{Name: "noisy*", Sampler: &SamplerConfig{Name: "never"}}},
+	})
+	ctx := context.Background()
+	if sample(s, ctx, "noisy.loop", api.SpanKindInternal) {
+		t.Error("root span should follow rules")
+	}
+	parent := api.ContextWithSpanContext(ctx, api.NewSpanContext(api.SpanContextConfig{
+		TraceID:    api.TraceID{1},
+		SpanID:     api.SpanID{1},
+		TraceFlags: api.FlagsSampled,
+	}))
+	if !sample(s, parent, "noisy.loop", api.SpanKindInternal) {
+		t.Error("child of sampled parent should be sampled")
+	}
+}
+
+func TestGlob(t *testing.T) {
+	cases := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"*", "", true},
+		{"a*c", "abbc", true},
+		{"a?c", "abc", true},
+		{"a?c", "abbc", false},
+		{"*.sql", "query.sql", true},
+		{"GET *", "POST /", false},
+	}
+	for _, c := range cases {
+		if got := glob(c.pattern, c.s); got != c.want {
+			t.Errorf("glob(%q,%q): want %v got %v", c.pattern, c.s, c.want, got)
+		}
+	}
+}
+
+func TestRateLimitSampler(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newRateLimitSampler(2, func() time.Time { return now })
+	p := trace.SamplingParameters{ParentContext: context.Background(), TraceID: api.TraceID{1}, Name: "op"}
+	count := func(n int) (sampled int) {
+		for i := 0; i < n; i++ {
+			if r := s.ShouldSample(p); r.Decision == trace.RecordAndSample {
+				sampled++
+				if len(r.Attributes) != 2 || r.Attributes[1].Value.AsFloat64() != 2 {
+					t.Fatalf("attributes %v", r.Attributes)
+				}
+			}
+		}
+		return
+	}
+	if n := count(10); n != 2 {
+		t.Fatalf("initial burst want 2 got %d", n)
+	}
+	now = now.Add(500 * time.Millisecond)
+	if n := count(10); n != 1 {
+		t.Fatalf("after 500ms want 1 got %d", n)
+	}
+	now = now.Add(time.Minute)
+	if n := count(10); n != 2 {
+		t.Fatalf("bucket capped, want 2 got %d", n)
+	}
+	slow := newRateLimitSampler(0.5, func() time.Time { return now })
+	if slow.ShouldSample(p).Decision != trace.RecordAndSample || slow.ShouldSample(p).Decision != trace.Drop {
+		t.Fatal("fractional rate holds one token")
+	}
+	now = now.Add(2 * time.Second)
+	if slow.ShouldSample(p).Decision != trace.RecordAndSample {
+		t.Fatal("fractional rate refill")
+	}
+}
+
+func TestRateLimitSamplerParent(t *testing.T) {
+	s := NewSampler(&SamplerConfig{Name: "parent", Based: "ratelimit", Rate: sql.NullFloat64{Float64: 1, Valid: true}})
+	ctx := context.Background()
+	if !sample(s, ctx, "a", api.SpanKindServer) || sample(s, ctx, "b", api.SpanKindServer) {
+		t.Fatal("root spans should be rate limited")
+	}
+	parent := api.ContextWithSpanContext(ctx, api.NewSpanContext(api.SpanContextConfig{
+		TraceID:    api.TraceID{1},
+		SpanID:     api.SpanID{1},
+		TraceFlags: api.FlagsSampled,
+	}))
+	if !sample(s, parent, "c", api.SpanKindInternal) {
+		t.Fatal("children of sampled trace should not consume the limit")
+	}
+	root := NewSampler(&SamplerConfig{Name: "ratelimit", Rate: sql.NullFloat64{Float64: 1, Valid: true}})
+	if !sample(root, ctx, "a", api.SpanKindServer) || !sample(root, parent, "child", api.SpanKindInternal) {
+		t.Fatal("child span of a top level ratelimit sampler should follow the sampled parent")
+	}
+	unsampled := api.ContextWithSpanContext(ctx, api.NewSpanContext(api.SpanContextConfig{TraceID: api.TraceID{2}, SpanID: api.SpanID{2}}))
+	if sample(root, unsampled, "child", api.SpanKindInternal) {
+		t.Fatal("child span of a dropped trace should be dropped")
+	}
+	if NewSampler(&SamplerConfig{Name: "ratelimit"}) != nil {
+		t.Fatal("ratelimit without rate should fallback to default")
+	}
+}
@@ -0,0 +1,285 @@
+package otlp
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/trace"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+// TailConfig buffers ended spans per trace and exports whole traces which have an error span,
+// a slow span or a span matching Attributes, others are kept by Ratio.
+// Head sampler should sample all traces that tail sampling wants to see.
+type TailConfig struct {
+	Decision   time.Duration     //wait after the first ended span of a trace before decision, default 10s
+	Error      sql.NullBool      //keep traces with error span, default true
+	Latency    time.Duration     //keep traces with span longer than, 0 disabled
+	Attributes map[string]string //keep traces with span attribute value matching glob
+	Ratio      sql.NullFloat64   //baseline probability of other traces, default 0
+	MaxTraces  sql.NullInt32     //traces buffered, the oldest is evicted when full, default 10000
+	MaxSpans   sql.NullInt32     //spans buffered per trace, more spans are dropped, default 1000
+	Remember   time.Duration     //decision of a trace is followed by its late spans within, default 1m
+}
+
+// TailStats counters of tail sampling
+type TailStats struct {
+	Kept         int64 //traces exported
+	Dropped      int64 //traces not matched
+	Evicted      int64 //traces evicted before decision by MaxTraces, traces already matched are exported as kept
+	DroppedSpans int64 //spans dropped by MaxSpans
+}
+
+type pendingTrace struct {
+	id    api.TraceID
+	since time.Time
+	keep  bool
+	spans []trace.ReadOnlySpan
+}
+
+type decision struct {
+	id api.TraceID
+	at time.Time
+}
+
+// TailProcessor tail sampling processor in front of the export processor
+type TailProcessor struct {
+	next      trace.SpanProcessor
+	decision  time.Duration
+	keepError bool
+	latency   time.Duration
+	attrs     map[string]string
+	baseline  trace.Sampler
+	maxTraces int
+	maxSpans  int
+	remember  time.Duration
+
+	mu      sync.Mutex
+	traces  map[api.TraceID]*pendingTrace
+	order   []api.TraceID        //arrival order for eviction and decision
+	decided map[api.TraceID]bool //keep of decided traces for late spans
+	expiry  []decision           //decision order for forgetting
+
+	kept, dropped, evicted, droppedSpans atomic.Int64
+	counter                              metric.Int64Counter
+	stop                                 chan struct{}
+	done                                 chan struct{}
+	once                                 sync.Once
+}
+
+// NewTailProcessor create tail sampling processor exporting through next
+func NewTailProcessor(next trace.SpanProcessor, cfg *TailConfig) *TailProcessor {
+	p := &TailProcessor{
+		next:      next,
+		decision:  cfg.Decision,
+		keepError: !cfg.Error.Valid || cfg.Error.Bool,
+		latency:   cfg.Latency,
+		attrs:     cfg.Attributes,
+		baseline:  trace.TraceIDRatioBased(cfg.Ratio.Float64),
+		maxTraces: 10000,
+		maxSpans:  1000,
+		remember:  cfg.Remember,
+		traces:    make(map[api.TraceID]*pendingTrace),
+		decided:   make(map[api.TraceID]bool),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if p.decision <= 0 {
+		p.decision = 10 * time.Second
+	}
+	if p.remember <= 0 {
+		p.remember = time.Minute
+	}
+	//!! invalid limits keep the defaults, a non-strict setup still runs with them
+	if cfg.MaxTraces.Valid && cfg.MaxTraces.Int32 > 0 {
+		p.maxTraces = int(cfg.MaxTraces.Int32)
+	}
+	if cfg.MaxSpans.Valid && cfg.MaxSpans.Int32 > 0 {
+		p.maxSpans = int(cfg.MaxSpans.Int32)
+	}
+	p.counter, _ = otel.Meter("github.com/ZenLiuCN/ote/otlp").Int64Counter("otel.tail_sampling.traces",
+		metric.WithDescription("traces decided by tail sampling"))
+	go p.run()
+	return p
+}
+
+func (p *TailProcessor) run() {
+	defer close(p.done)
+	t := time.NewTicker(max(p.decision/10, 10*time.Millisecond))
+	defer t.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-t.C:
+			p.decide(time.Now().Add(-p.decision))
+		}
+	}
+}
+
+func (p *TailProcessor) OnStart(parent context.Context, s trace.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+func (p *TailProcessor) OnEnd(s trace.ReadOnlySpan) {
+	id := s.SpanContext().TraceID()
+	p.mu.Lock()
+	if keep, ok := p.decided[id]; ok {
+		p.mu.Unlock()
+		//!! late span follows the decision of its trace
+		if keep {
+			p.next.OnEnd(s)
+		}
+		return
+	}
+	var evicted *pendingTrace
+	t, ok := p.traces[id]
+	if !ok {
+		if len(p.order) >= p.maxTraces {
+			evicted = p.evict()
+		}
+		t = &pendingTrace{id: id, since: time.Now()}
+		p.traces[id] = t
+		p.order = append(p.order, id)
+	}
+	t.keep = t.keep || p.match(s)
+	if len(t.spans) >= p.maxSpans {
+		p.droppedSpans.Add(1)
+	} else {
+		t.spans = append(t.spans, s)
+	}
+	p.mu.Unlock()
+	if evicted != nil {
+		p.export(evicted)
+	}
+}
+
+// evict the oldest trace, returns the trace to export if already matched, must hold lock
+func (p *TailProcessor) evict() *pendingTrace {
+	id := p.order[0]
+	p.order = p.order[1:]
+	t := p.traces[id]
+	delete(p.traces, id)
+	if t.keep {
+		p.resolve(t, time.Now())
+		return t
+	}
+	p.evicted.Add(1)
+	p.record("evicted")
+	return nil
+}
+
+// resolve count the decision of t and remember it for late spans, must hold lock
+func (p *TailProcessor) resolve(t *pendingTrace, now time.Time) {
+	p.decided[t.id] = t.keep
+	p.expiry = append(p.expiry, decision{id: t.id, at: now})
+	if t.keep {
+		p.kept.Add(1)
+		p.record("kept")
+	} else {
+		p.dropped.Add(1)
+		p.record("dropped")
+	}
+}
+
+// forget decisions made before deadline, must hold lock
+func (p *TailProcessor) forget(deadline time.Time) {
+	n := 0
+	for _, d := range p.expiry {
+		if d.at.After(deadline) {
+			break
+		}
+		delete(p.decided, d.id)
+		n++
+	}
+	p.expiry = p.expiry[n:]
+}
+
+func (p *TailProcessor) match(s trace.ReadOnlySpan) bool {
+	if p.keepError && s.Status().Code == codes.Error {
+		return true
+	}
+	if p.latency > 0 && s.EndTime().Sub(s.StartTime()) >= p.latency {
+		return true
+	}
+	if len(p.attrs) > 0 {
+		for _, kv := range s.Attributes() {
+			if pattern, ok := p.attrs[string(kv.Key)]; ok && glob(pattern, kv.Value.Emit()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// decide traces arrived not after deadline, zero deadline decides all
+func (p *TailProcessor) decide(deadline time.Time) {
+	var ready []*pendingTrace
+	now := time.Now()
+	p.mu.Lock()
+	n := 0
+	for _, id := range p.order {
+		t := p.traces[id]
+		if !deadline.IsZero() && t.since.After(deadline) {
+			break
+		}
+		delete(p.traces, id)
+		n++
+		t.keep = t.keep || p.baseline.ShouldSample(trace.SamplingParameters{TraceID: t.id}).Decision == trace.RecordAndSample
+		p.resolve(t, now)
+		if t.keep {
+			ready = append(ready, t)
+		}
+	}
+	p.order = p.order[n:]
+	p.forget(now.Add(-p.remember))
+	p.mu.Unlock()
+	for _, t := range ready {
+		p.export(t)
+	}
+}
+
+// export spans of a kept trace
+func (p *TailProcessor) export(t *pendingTrace) {
+	for _, s := range t.spans {
+		p.next.OnEnd(s)
+	}
+}
+
+func (p *TailProcessor) record(decision string) {
+	if p.counter != nil {
+		p.counter.Add(context.Background(), 1, metric.WithAttributes(attribute.String("decision", decision)))
+	}
+}
+
+// Stats current counters
+func (p *TailProcessor) Stats() TailStats {
+	return TailStats{
+		Kept:         p.kept.Load(),
+		Dropped:      p.dropped.Load(),
+		Evicted:      p.evicted.Load(),
+		DroppedSpans: p.droppedSpans.Load(),
+	}
+}
+
+// Shutdown decide all buffered traces then shutdown next
+func (p *TailProcessor) Shutdown(ctx context.Context) error {
+	p.once.Do(func() {
+		close(p.stop)
+		<-p.done
+	})
+	p.decide(time.Time{})
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flush traces already decided by next, buffered traces keep waiting for their decision
+func (p *TailProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
@@ -0,0 +1,230 @@
+package otlp
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"time"
+
+	. "github.com/ZenLiuCN/ote/resource"
+	otlp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+)
+
+var (
+	compressions = []string{"", "gzip", "none"}
+	samplers     = []string{"always", "never", "ratio", "rules", "ratelimit", "remote", "parent"}
+	basedOn      = []string{"always", "never", "ratio", "rules", "ratelimit", "remote"}
+	parentOption = []string{"withRemote", "withoutRemote", "withLocal", "withoutLocal"}
+)
+
+// Validate returns all problems of the config as FieldErrors
+func (c *TraceConfig) Validate() error {
+	var errs FieldErrors
+	oneOf(&errs, "Protocol", c.Protocol, []string{"", ProtocolGRPC, ProtocolHTTP, ProtocolJSON})
+	endpoint(&errs, "Endpoint", c.Endpoint, c.Protocol)
+	oneOf(&errs, "Compress", c.Compress, compressions)
+	duration(&errs, "Reconnect", c.Reconnect)
+	duration(&errs, "Timeout", c.Timeout)
+	retry(&errs, "Retry", c.Retry)
+	duration(&errs, "ExportTimeout", c.ExportTimeout)
+	duration(&errs, "ExportBatchTimeout", c.ExportBatchTimeout)
+	positive(&errs, "ExportBatchSize", c.ExportBatchSize)
+	positive(&errs, "QueueSize", c.QueueSize)
+	if c.ExportBatchSize.Valid && c.QueueSize.Valid && c.ExportBatchSize.Int32 > c.QueueSize.Int32 {
+		errs.Add("ExportBatchSize", c.ExportBatchSize.Int32, "must not be greater than QueueSize")
+	}
+	for i, p := range c.Propagators {
+		if _, ok := propagators[p]; !ok {
+			errs.Add(fmt.Sprintf("Propagators[%d]", i), p, "unknown propagator")
+		}
+	}
+	if c.Sampler != nil {
+		errs.Merge("Sampler", c.Sampler.Validate())
+	}
+	if c.Metric != nil {
+		errs.Merge("Metric", c.Metric.Validate())
+	}
+	if c.Log != nil {
+		errs.Merge("Log", c.Log.Validate())
+	}
+	if c.Tail != nil {
+		errs.Merge("Tail", c.Tail.Validate())
+	}
+	if c.Config == nil {
+		errs.Add("Config", nil, "resource config required")
+	} else {
+		errs.Merge("Config", c.Config.Validate())
+	}
+	return errs.Err()
+}
+
+func (c *SamplerConfig) Validate() error {
+	var errs FieldErrors
+	if c.Name == "" {
+		errs.Add("Name", c.Name, "sampler name required")
+	} else {
+		oneOf(&errs, "Name", c.Name, samplers)
+	}
+	if c.Name == "parent" {
+		oneOf(&errs, "Based", c.Based, basedOn)
+		for i, o := range c.Options {
+			oneOf(&errs, fmt.Sprintf("Options[%d]", i), o, parentOption)
+		}
+	} else {
+		if c.Based != "" {
+			errs.Add("Based", c.Based, "only used by parent sampler")
+		}
+		if len(c.Options) > 0 {
+			errs.Add("Options", c.Options, "only used by parent sampler")
+		}
+	}
+	if c.Ratio.Valid && (c.Ratio.Float64 < 0 || c.Ratio.Float64 > 1) {
+		errs.Add("Ratio", c.Ratio.Float64, "must be in [0,1]")
+	}
+	if c.Name == "ratelimit" || c.Based == "ratelimit" {
+		if !c.Rate.Valid || c.Rate.Float64 <= 0 {
+			errs.Add("Rate", c.Rate.Float64, "must be positive")
+		}
+	} else if c.Rate.Valid {
+		errs.Add("Rate", c.Rate.Float64, "only used by ratelimit sampler")
+	}
+	rules, remote := c.Name == "rules" || c.Based == "rules", c.Name == "remote" || c.Based == "remote"
+	if rules {
+		for i, r := range c.Rules {
+			errs.Merge(fmt.Sprintf("Rules[%d]", i), r.Validate())
+		}
+	} else if len(c.Rules) > 0 {
+		errs.Add("Rules", c.Rules, "only used by rules sampler")
+	}
+	if c.Default != nil {
+		if rules || remote {
+			errs.Merge("Default", c.Default.Validate())
+		} else {
+			errs.Add("Default", c.Default, "only used by rules or remote sampler")
+		}
+	}
+	if remote {
+		if c.Endpoint == "" {
+			errs.Add("Endpoint", c.Endpoint, "required by remote sampler")
+		}
+		endpoint(&errs, "Endpoint", c.Endpoint, "")
+		duration(&errs, "Interval", c.Interval)
+	} else {
+		if c.Endpoint != "" {
+			errs.Add("Endpoint", c.Endpoint, "only used by remote sampler")
+		}
+		if c.Interval != 0 {
+			errs.Add("Interval", c.Interval, "only used by remote sampler")
+		}
+		if c.Service != "" {
+			errs.Add("Service", c.Service, "only used by remote sampler")
+		}
+	}
+	return errs.Err()
+}
+
+func (r *SamplerRule) Validate() error {
+	var errs FieldErrors
+	if r.NameRegex != "" {
+		if _, err := regexp.Compile(r.NameRegex); err != nil {
+			errs.Add("NameRegex", r.NameRegex, err.Error())
+		}
+	}
+	if _, ok := spanKinds[r.Kind]; r.Kind != "" && !ok {
+		errs.Add("Kind", r.Kind, "not one of internal|server|client|producer|consumer")
+	}
+	if r.Sampler == nil {
+		errs.Add("Sampler", nil, "sampler required")
+	} else {
+		errs.Merge("Sampler", r.Sampler.Validate())
+	}
+	return errs.Err()
+}
+
+func (c *TailConfig) Validate() error {
+	var errs FieldErrors
+	duration(&errs, "Decision", c.Decision)
+	duration(&errs, "Latency", c.Latency)
+	if c.Ratio.Valid && (c.Ratio.Float64 < 0 || c.Ratio.Float64 > 1) {
+		errs.Add("Ratio", c.Ratio.Float64, "must be in [0,1]")
+	}
+	positive(&errs, "MaxTraces", c.MaxTraces)
+	positive(&errs, "MaxSpans", c.MaxSpans)
+	duration(&errs, "Remember", c.Remember)
+	return errs.Err()
+}
+
+func (c *MetricConfig) Validate() error {
+	var errs FieldErrors
+	oneOf(&errs, "Protocol", c.Protocol, []string{"", ProtocolGRPC, ProtocolHTTP})
+	endpoint(&errs, "Endpoint", c.Endpoint, c.Protocol)
+	oneOf(&errs, "Compress", c.Compress, compressions)
+	duration(&errs, "Reconnect", c.Reconnect)
+	duration(&errs, "Timeout", c.Timeout)
+	retry(&errs, "Retry", c.Retry)
+	duration(&errs, "Interval", c.Interval)
+	duration(&errs, "ExportTimeout", c.ExportTimeout)
+	oneOf(&errs, "Temporality", c.Temporality, []string{"", "cumulative", "delta", "lowmemory"})
+	oneOf(&errs, "Aggregation", c.Aggregation, []string{"", "explicit_bucket_histogram", "base2_exponential_bucket_histogram"})
+	return errs.Err()
+}
+
+func (c *LogConfig) Validate() error {
+	var errs FieldErrors
+	oneOf(&errs, "Protocol", c.Protocol, []string{"", ProtocolGRPC, ProtocolHTTP})
+	endpoint(&errs, "Endpoint", c.Endpoint, c.Protocol)
+	oneOf(&errs, "Compress", c.Compress, compressions)
+	duration(&errs, "Reconnect", c.Reconnect)
+	duration(&errs, "Timeout", c.Timeout)
+	retry(&errs, "Retry", c.Retry)
+	duration(&errs, "ExportInterval", c.ExportInterval)
+	duration(&errs, "ExportTimeout", c.ExportTimeout)
+	positive(&errs, "ExportMaxBatch", c.ExportMaxBatch)
+	positive(&errs, "QueueSize", c.QueueSize)
+	return errs.Err()
+}
+
+func oneOf(errs *FieldErrors, path, value string, values []string) {
+	if !slices.Contains(values, value) {
+		errs.Add(path, value, fmt.Sprintf("not one of %q", values))
+	}
+}
+
+// endpoint check value as the exporter of protocol parses it, http exporters also take host:port
+func endpoint(errs *FieldErrors, path, value, protocol string) {
+	if value == "" {
+		return
+	}
+	if protocol == ProtocolHTTP || protocol == ProtocolJSON {
+		if resolved, err := httpEndpoint(value, false, "/"); err == nil {
+			if u, err := url.Parse(resolved); err == nil && u.Host != "" {
+				return
+			}
+		}
+		errs.Add(path, value, "must be an url like http://host:port or host:port")
+		return
+	}
+	if u, err := url.Parse(value); err != nil || u.Host == "" {
+		errs.Add(path, value, "must be an url like http://host:port")
+	}
+}
+func duration(errs *FieldErrors, path string, value time.Duration) {
+	if value < 0 {
+		errs.Add(path, value, "must not be negative")
+	}
+}
+func positive(errs *FieldErrors, path string, value sql.NullInt32) {
+	if value.Valid && value.Int32 <= 0 {
+		errs.Add(path, value.Int32, "must be positive")
+	}
+}
+func retry(errs *FieldErrors, path string, value *otlp.RetryConfig) {
+	if value == nil {
+		return
+	}
+	duration(errs, path+".InitialInterval", value.InitialInterval)
+	duration(errs, path+".MaxInterval", value.MaxInterval)
+	duration(errs, path+".MaxElapsedTime", value.MaxElapsedTime)
+}
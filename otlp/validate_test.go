@@ -0,0 +1,55 @@
+package otlp
+
+import (
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+
+	. "github.com/ZenLiuCN/ote/resource"
+)
+
+func TestValidate(t *testing.T) {
+	c := &TraceConfig{
+		Protocol:        "http",
+		Endpoint:        "localhost:4317",
+		ExportBatchSize: sql.NullInt32{Int32: 1024, Valid: true},
+		QueueSize:       sql.NullInt32{Int32: 512, Valid: true},
+		Propagators:     []string{"tracecontext", "w3c"},
+		Sampler: &SamplerConfig{
+			Name:    "parent",
+			Based:   "ration",
+			Ratio:   sql.NullFloat64{Float64: 2, Valid: true},
+			Options: []string{"withRemote", "withRemoteParent"},
+		},
+		Metric: &MetricConfig{Temporality: "deltas"},
+		Config: &Config{Service: sql.NullString{Valid: true}},
+	}
+	err := c.Validate()
+	var fe FieldErrors
+	if !errors.As(err, &fe) {
+		t.Fatalf("expect FieldErrors: %v", err)
+	}
+	var paths []string
+	for _, e := range fe {
+		paths = append(paths, e.Path)
+	}
+	for _, p := range []string{"Protocol", "Endpoint", "ExportBatchSize", "Propagators[1]", "Sampler.Based", "Sampler.Ratio", "Sampler.Options[1]", "Metric.Temporality", "Config.Service"} {
+		if !slices.Contains(paths, p) {
+			t.Fatalf("missing %s in %v", p, paths)
+		}
+	}
+	if slices.Contains(paths, "Sampler.Options[0]") {
+		t.Fatalf("valid option reported: %v", paths)
+	}
+	if err = (&TraceConfig{Sampler: &SamplerConfig{Name: "ratio"}, Config: &Config{}}).Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err = (&TraceConfig{Protocol: ProtocolHTTP, Endpoint: "localhost:4318", Config: &Config{}}).Validate(); err != nil {
+		t.Fatalf("host:port of http exporter: %v", err)
+	}
+	err = (&SamplerConfig{Name: "always", Service: "demo"}).Validate()
+	if !errors.As(err, &fe) || len(fe) != 1 || fe[0].Path != "Service" {
+		t.Fatalf("remote only field: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package otlp
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	api "go.opentelemetry.io/otel/trace"
+)
+
+const xrayHeader = "X-Amzn-Trace-Id"
+
+// xrayPropagator aws x-ray propagation of Root=1-{epoch}-{random};Parent={span-id};Sampled={0|1},
+// the trace id is the epoch and random hex joined. A deferred Sampled=? is extracted as not sampled.
+type xrayPropagator struct{}
+
+func (xrayPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := api.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	sampled := "0"
+	if sc.IsSampled() {
+		sampled = "1"
+	}
+	id := sc.TraceID().String()
+	carrier.Set(xrayHeader, "Root=1-"+id[:8]+"-"+id[8:]+";Parent="+sc.SpanID().String()+";Sampled="+sampled)
+}
+
+func (xrayPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	v := carrier.Get(xrayHeader)
+	if v == "" {
+		return ctx
+	}
+	cfg := api.SpanContextConfig{Remote: true}
+	var root, parent bool
+	for _, part := range strings.Split(v, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "Root":
+			//!! 1-xxxxxxxx-xxxxxxxxxxxxxxxxxxxxxxxx
+			if len(value) != 35 || value[:2] != "1-" || value[10] != '-' {
+				return ctx
+			}
+			cfg.TraceID, root = traceIDFromHex(value[2:10] + value[11:])
+		case "Parent":
+			if len(value) != 16 {
+				return ctx
+			}
+			cfg.SpanID, parent = spanIDFromHex(value)
+		case "Sampled":
+			if value == "1" {
+				cfg.TraceFlags = api.FlagsSampled
+			}
+		}
+	}
+	if !root || !parent {
+		return ctx
+	}
+	return api.ContextWithRemoteSpanContext(ctx, api.NewSpanContext(cfg))
+}
+
+func (xrayPropagator) Fields() []string {
+	return []string{xrayHeader}
+}
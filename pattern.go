@@ -0,0 +1,12 @@
+//go:build go1.23
+
+package ote
+
+import "net/http"
+
+// hasPattern http.Request.Pattern is set by http.ServeMux
+const hasPattern = true
+
+func requestPattern(r *http.Request) string {
+	return r.Pattern
+}
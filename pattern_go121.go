@@ -0,0 +1,12 @@
+//go:build !go1.23
+
+package ote
+
+import "net/http"
+
+// hasPattern http.Request.Pattern requires go1.23, spans are named by method only
+const hasPattern = false
+
+func requestPattern(*http.Request) string {
+	return ""
+}
@@ -1,12 +1,11 @@
 package ote
 
 import (
+	"github.com/ZenLiuCN/ote/otlp"
 	"go.opentelemetry.io/otel/propagation"
 )
 
 func NewPropagator() propagation.TextMapPropagator {
-	return propagation.NewCompositeTextMapPropagator(
-		propagation.TraceContext{},
-		propagation.Baggage{},
-	)
+	p, _ := otlp.NewPropagator(nil)
+	return p
 }
@@ -7,7 +7,8 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric"
 )
 
-func NewMeterProvider(ctx context.Context, c *Config) (*metric.MeterProvider, error) {
+// NewMeterProvider create MeterProvider with prometheus reader and extra readers
+func NewMeterProvider(ctx context.Context, c *Config, readers ...metric.Reader) (*metric.MeterProvider, error) {
 	var opt []prometheus.Option
 	{
 
@@ -20,6 +21,9 @@ func NewMeterProvider(ctx context.Context, c *Config) (*metric.MeterProvider, er
 	var mpo []metric.Option
 	{
 		mpo = append(mpo, metric.WithReader(metricExporter))
+		for _, reader := range readers {
+			mpo = append(mpo, metric.WithReader(reader))
+		}
 		res, err := ParseResource(ctx, c)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,88 @@
+package ote
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/ZenLiuCN/ote/config"
+	"github.com/ZenLiuCN/ote/otlp"
+	"go.opentelemetry.io/otel"
+)
+
+// ReloadTelemetry apply sampler, exporter and propagator settings of conf to the running telemetry,
+// in-flight spans drain to the old exporter. Resource, metric and log settings are not reloaded.
+func ReloadTelemetry(ctx context.Context, conf *otlp.TraceConfig) error {
+	if !HaveTelemetry() || tracerProvider == nil {
+		return errors.New("telemetry not setup")
+	}
+	if err := conf.Validate(); err != nil {
+		if conf.Strict {
+			return err
+		}
+		slog.Warn("telemetry config invalid, continue with it", "error", err)
+	}
+	prop, err := otlp.NewPropagator(conf.Propagators)
+	if err != nil {
+		return err
+	}
+	if err = tracerProvider.Reload(ctx, conf); err != nil {
+		return err
+	}
+	otel.SetTextMapPropagator(prop)
+	return nil
+}
+
+// ReloadTelemetryFromFile reload with declarative config file, see ReloadTelemetry
+func ReloadTelemetryFromFile(ctx context.Context, path string) error {
+	doc, err := config.Load(path)
+	if err != nil {
+		return err
+	}
+	conf, err := doc.TraceConfig()
+	if err != nil {
+		return err
+	}
+	return ReloadTelemetry(ctx, conf)
+}
+
+// WatchTelemetryFile poll the config file every interval (default one second) in background
+// and reload telemetry when it changes, until ctx is done. Reload failures are logged.
+func WatchTelemetryFile(ctx context.Context, path string, interval time.Duration) error {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if interval <= 0 {
+		interval = time.Second
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		mod, size := stat.ModTime(), stat.Size()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s, err := os.Stat(path)
+				if err != nil {
+					slog.Error("telemetry config watch failed", "path", path, "error", err)
+					continue
+				}
+				if s.ModTime().Equal(mod) && s.Size() == size {
+					continue
+				}
+				mod, size = s.ModTime(), s.Size()
+				if err = ReloadTelemetryFromFile(ctx, path); err != nil {
+					slog.Error("telemetry config reload failed", "path", path, "error", err)
+				} else {
+					slog.Info("telemetry config reloaded", "path", path)
+				}
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,130 @@
+package ote
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ZenLiuCN/ote/otlp"
+	"github.com/ZenLiuCN/ote/resource"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// traceCollector count trace exports
+func traceCollector(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	n := new(atomic.Int32)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/traces" {
+			n.Add(1)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s, n
+}
+
+func setupReloadable(t *testing.T, endpoint string) {
+	ctx := context.Background()
+	conf := &otlp.TraceConfig{
+		Protocol: otlp.ProtocolHTTP,
+		Endpoint: endpoint,
+		Insecure: sql.NullBool{Bool: true, Valid: true},
+		Config:   &resource.Config{},
+	}
+	tp, err := otlp.NewReloadableTraceProvider(ctx, conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracerProvider = tp
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(NewPropagator())
+	shutdown = tp.Shutdown
+	t.Cleanup(func() {
+		_ = tp.Shutdown(ctx)
+		shutdown, tracerProvider = nil, nil
+	})
+}
+
+func TestReloadTelemetry(t *testing.T) {
+	ctx := context.Background()
+	if err := ReloadTelemetry(ctx, &otlp.TraceConfig{}); err == nil {
+		t.Fatal("expect not setup error")
+	}
+	a, na := traceCollector(t)
+	b, nb := traceCollector(t)
+	setupReloadable(t, a.URL)
+	te := NewTelemetry("test")
+	conf := &otlp.TraceConfig{
+		Protocol:    otlp.ProtocolHTTP,
+		Endpoint:    b.URL,
+		Insecure:    sql.NullBool{Bool: true, Valid: true},
+		Propagators: []string{"b3"},
+		Config:      &resource.Config{},
+	}
+	if err := ReloadTelemetry(ctx, conf); err != nil {
+		t.Fatal(err)
+	}
+	cx, sp := te.StartSpan("after", te.SetContext(ctx))
+	//!! Telemetry created before reload injects with the reloaded propagator
+	headers := propagation.MapCarrier{}
+	InjectMessage(cx, headers)
+	sp.End()
+	if headers.Get("b3") == "" || headers.Get("traceparent") != "" {
+		t.Fatalf("propagator not reloaded %v", headers)
+	}
+	if err := tracerProvider.ForceFlush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if na.Load() != 0 || nb.Load() != 1 {
+		t.Fatalf("exports old %d new %d", na.Load(), nb.Load())
+	}
+}
+
+func TestWatchTelemetryFile(t *testing.T) {
+	a, _ := traceCollector(t)
+	setupReloadable(t, a.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	path := filepath.Join(t.TempDir(), "telemetry.yaml")
+	write := func(propagator string) {
+		if err := os.WriteFile(path, []byte(`
+resource:
+  attributes:
+    service.name: demo
+propagator:
+  composite: [`+propagator+`]
+tracer_provider:
+  processors:
+    - batch:
+        exporter:
+          otlp:
+            protocol: http/protobuf
+            endpoint: `+a.URL+`
+            insecure: true
+`), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("tracecontext")
+	if err := WatchTelemetryFile(ctx, filepath.Join(t.TempDir(), "missing.yaml"), 0); err == nil {
+		t.Fatal("expect missing file error")
+	}
+	if err := WatchTelemetryFile(ctx, path, 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	write("b3, baggage")
+	deadline := time.Now().Add(5 * time.Second)
+	for !slices.Contains(otel.GetTextMapPropagator().Fields(), "b3") {
+		if time.Now().After(deadline) {
+			t.Fatalf("not reloaded, fields %v", otel.GetTextMapPropagator().Fields())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
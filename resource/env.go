@@ -0,0 +1,57 @@
+package resource
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// EnvError reports an environment variable with unknown or malformed value
+type EnvError struct {
+	Name  string
+	Value string
+	Err   error
+}
+
+func (e *EnvError) Error() string {
+	return fmt.Sprintf("telemetry.env %s=%q: %s", e.Name, e.Value, e.Err)
+}
+func (e *EnvError) Unwrap() error {
+	return e.Err
+}
+
+// FromEnv fill unset fields from OTEL_SERVICE_NAME, values already set take precedence.
+// OTEL_RESOURCE_ATTRIBUTES is validated, it's applied by ParseResource when Env is enabled.
+func (c *Config) FromEnv() error {
+	if v, ok := os.LookupEnv("OTEL_SERVICE_NAME"); ok && !c.Service.Valid {
+		if v == "" {
+			return &EnvError{Name: "OTEL_SERVICE_NAME", Value: v, Err: fmt.Errorf("empty service name")}
+		}
+		c.Service.String = v
+		c.Service.Valid = true
+	}
+	if v, ok := os.LookupEnv("OTEL_RESOURCE_ATTRIBUTES"); ok {
+		if err := resourceAttributes(v); err != nil {
+			return &EnvError{Name: "OTEL_RESOURCE_ATTRIBUTES", Value: v, Err: err}
+		}
+	}
+	return nil
+}
+
+// resourceAttributes check v is a list of key=value separated by comma, values are percent encoded
+func resourceAttributes(v string) error {
+	for _, pair := range strings.Split(v, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, value, found := strings.Cut(pair, "=")
+		if key = strings.TrimSpace(key); !found || key == "" {
+			return fmt.Errorf("attribute %q not in form of key=value", pair)
+		}
+		if _, err := url.PathUnescape(strings.TrimSpace(value)); err != nil {
+			return fmt.Errorf("attribute %q: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FieldError a config problem at field path like Sampler.Options[1]
+type FieldError struct {
+	Path   string
+	Value  any
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s, got %#v", e.Path, e.Reason, e.Value)
+}
+
+// FieldErrors all problems found by Validate
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	s := make([]string, len(e))
+	for i, fe := range e {
+		s[i] = fe.Error()
+	}
+	return "telemetry config invalid: " + strings.Join(s, "; ")
+}
+func (e FieldErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// Add a problem at path
+func (e *FieldErrors) Add(path string, value any, reason string) {
+	*e = append(*e, &FieldError{Path: path, Value: value, Reason: reason})
+}
+
+// Merge problems of a nested Validate with path prefix
+func (e *FieldErrors) Merge(prefix string, err error) {
+	var fe FieldErrors
+	if err == nil {
+		return
+	} else if errors.As(err, &fe) {
+		for _, x := range fe {
+			e.Add(prefix+"."+x.Path, x.Value, x.Reason)
+		}
+	} else {
+		e.Add(prefix, nil, err.Error())
+	}
+}
+
+// Err returns nil when there is no problem
+func (e FieldErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (c *Config) Validate() error {
+	var errs FieldErrors
+	if c.Service.Valid && strings.TrimSpace(c.Service.String) == "" {
+		errs.Add("Service", c.Service.String, "service name must not be blank")
+	}
+	return errs.Err()
+}
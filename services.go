@@ -6,19 +6,31 @@ import (
 	rt "go.opentelemetry.io/contrib/instrumentation/runtime"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/propagation"
+	sem "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"go.opentelemetry.io/otel/trace"
 	"net/http"
 	"runtime"
+	"runtime/debug"
+	"sync/atomic"
 	"time"
 )
 
 const (
 	Version = "0.0.1"
 )
+
+// ContextKey the legacy string key of Telemetry in context, values stored under it are still honoured by FromContext,
+// SetContext only stores under the private typed key so others using the string cannot shadow it.
+//
+// Deprecated: use FromContext and SetContext, will be removed in next minor version.
 const ContextKey = "$telemetry"
 
+// contextKey the key of Telemetry in context
+type contextKey struct{}
+
 type (
 	TelemetryProviderFn func() (scope string, opt []trace.SpanStartOption)
 	SpanProviderFn      func() (name string, attr []attribute.KeyValue)
@@ -37,6 +49,10 @@ func FromContext(ctx context.Context) (r Telemetry) {
 	if ctx == nil {
 		return nil
 	}
+	if r, ok := ctx.Value(contextKey{}).(Telemetry); ok {
+		return r
+	}
+	//!! compatible with values set by ctx.Value(ContextKey) users
 	r, ok := ctx.Value(ContextKey).(Telemetry)
 	if !ok {
 		return nil
@@ -44,14 +60,20 @@ func FromContext(ctx context.Context) (r Telemetry) {
 	return r
 }
 
+// SetContext store Telemetry into context, returns nil if ctx is nil
+func SetContext(ctx context.Context, t Telemetry) context.Context {
+	if ctx == nil {
+		return nil
+	}
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
 // SpanFromContext create span only context have a Telemetry
 func SpanFromContext(ctx context.Context, sn SpanProviderFn) (te Telemetry, sp trace.Span, cx context.Context) {
 	if ctx == nil {
 		return nil, nil, nil
 	}
-	var ok bool
-	te, ok = ctx.Value(ContextKey).(Telemetry)
-	if !ok {
+	if te = FromContext(ctx); te == nil {
 		return nil, nil, ctx
 	}
 	if sn != nil {
@@ -71,15 +93,14 @@ func ByContext(ctx context.Context, p TelemetryProviderFn) (r Telemetry, cx cont
 	if !HaveTelemetry() {
 		return nil, ctx
 	}
-	r, ok := ctx.Value(ContextKey).(Telemetry)
-	if !ok {
+	if r = FromContext(ctx); r == nil {
 		if p != nil {
 			s, o := p()
 			r = NewTelemetry(s, o...)
 		} else {
 			r = NewTelemetry(caller())
 		}
-		ctx = context.WithValue(ctx, ContextKey, r)
+		ctx = SetContext(ctx, r)
 	}
 	return r, ctx
 }
@@ -100,16 +121,14 @@ func SpanByContext(ctx context.Context, p TelemetryProviderFn, sn SpanProviderFn
 	if !HaveTelemetry() {
 		return nil, nil, ctx
 	}
-	var ok bool
-	te, ok = ctx.Value(ContextKey).(Telemetry)
-	if !ok {
+	if te = FromContext(ctx); te == nil {
 		if p != nil {
 			s, o := p()
 			te = NewTelemetry(s, o...)
 		} else {
 			te = NewTelemetry(caller())
 		}
-		ctx = context.WithValue(ctx, ContextKey, te)
+		ctx = SetContext(ctx, te)
 	}
 	if sn != nil {
 		n, a := sn()
@@ -142,33 +161,123 @@ type Telemetry interface {
 	*/
 	HandleRecover(rec any) (failure any, failed bool)
 
+	//RecordError record err as an exception event with stack trace on the span of ctx and set status error
+	RecordError(ctx context.Context, err error)
+	/*
+		RecordPanic record the recovered rec as an escaped exception event on the span of ctx and set status error,
+		the stack trace is the current goroutine stack, so call it in the deferred function of recover:
+			defer func() {
+				if r := recover(); r != nil {
+					tel.RecordPanic(ctx, r)
+					panic(r)
+				}
+			}()
+	*/
+	RecordPanic(ctx context.Context, rec any)
+
 	//StartSpan if ctx is nil then the returns are nil
 	StartSpan(name string, ctx context.Context, attrs ...attribute.KeyValue) (context.Context, trace.Span)
 
+	/*
+		StartSpanWith start span with options of each call after the options of NewTelemetry, such as
+		trace.WithSpanKind, trace.WithLinks (or LinkContexts), trace.WithTimestamp and trace.WithNewRoot.
+		If ctx is nil then the returns are nil.
+	*/
+	StartSpanWith(name string, ctx context.Context, opts ...trace.SpanStartOption) (context.Context, trace.Span)
+
+	/*
+		Counter, UpDownCounter, Histogram and Gauge returns the instrument of the Telemetry meter, instruments are cached
+		by name and unit, so they are cheap to fetch in hot paths. Measurements carry members of context baggage
+		configured by TraceConfig.MetricBaggage.
+	*/
+	Counter(name, unit string) Counter
+	UpDownCounter(name, unit string) UpDownCounter
+	//Histogram buckets only take effect at the first creation of name and unit
+	Histogram(name, unit string, buckets ...float64) Histogram
+	Gauge(name, unit string) Gauge
+
+	//ObservableCounter register fn to observe the counter of name and unit on each collection
+	ObservableCounter(name, unit string, fn ObserveFn) (metric.Registration, error)
+	//ObservableUpDownCounter register fn to observe the up-down counter of name and unit on each collection
+	ObservableUpDownCounter(name, unit string, fn ObserveFn) (metric.Registration, error)
+	//ObservableGauge register fn to observe the gauge of name and unit on each collection
+	ObservableGauge(name, unit string, fn ObserveFn) (metric.Registration, error)
+
 	SetContext(ctx context.Context) context.Context
 }
 type telemetry struct {
 	spanStartOption []trace.SpanStartOption
-	propagator      propagation.TextMapPropagator
 	meter           metric.Meter
 	tracer          trace.Tracer
 }
 
+// propagator the global one on each call, so a reloaded propagator takes effect on existing Telemetry
+func (t *telemetry) propagator() propagation.TextMapPropagator {
+	return otel.GetTextMapPropagator()
+}
+
 func (t *telemetry) StartSpan(name string, ctx context.Context, attrs ...attribute.KeyValue) (cx context.Context, sp trace.Span) {
 	if ctx == nil {
 		return nil, nil
 	}
-	cx, sp = t.tracer.Start(ctx, name, t.spanStartOption...)
-	if len(attrs) > 0 {
-		sp.SetAttributes(attrs...)
+	if len(attrs) == 0 {
+		return t.tracer.Start(ctx, name, t.spanStartOption...)
 	}
-	return
+	//!! attributes given at start are visible to samplers
+	return t.StartSpanWith(name, ctx, trace.WithAttributes(attrs...))
+}
+func (t *telemetry) StartSpanWith(name string, ctx context.Context, opts ...trace.SpanStartOption) (cx context.Context, sp trace.Span) {
+	if ctx == nil {
+		return nil, nil
+	}
+	if len(opts) == 0 {
+		return t.tracer.Start(ctx, name, t.spanStartOption...)
+	}
+	o := make([]trace.SpanStartOption, 0, len(t.spanStartOption)+len(opts))
+	o = append(append(o, t.spanStartOption...), opts...)
+	return t.tracer.Start(ctx, name, o...)
+}
+
+// LinkContexts option links to the valid span of each context, such as producer contexts of a batch
+func LinkContexts(ctxs ...context.Context) trace.SpanStartOption {
+	links := make([]trace.Link, 0, len(ctxs))
+	for _, c := range ctxs {
+		if sc := trace.SpanContextFromContext(c); sc.IsValid() {
+			links = append(links, trace.Link{SpanContext: sc})
+		}
+	}
+	return trace.WithLinks(links...)
 }
 func (t *telemetry) HandleError(err error) {
 	if err != nil {
 		otel.Handle(err)
 	}
 }
+func (t *telemetry) RecordError(ctx context.Context, err error) {
+	if err == nil || ctx == nil {
+		return
+	}
+	sp := trace.SpanFromContext(ctx)
+	sp.RecordError(err, trace.WithStackTrace(true))
+	sp.SetStatus(codes.Error, err.Error())
+}
+func (t *telemetry) RecordPanic(ctx context.Context, rec any) {
+	if rec == nil || ctx == nil {
+		return
+	}
+	sp := trace.SpanFromContext(ctx)
+	if !sp.IsRecording() {
+		return
+	}
+	msg := fmt.Sprint(rec)
+	sp.AddEvent(sem.ExceptionEventName, trace.WithAttributes(
+		sem.ExceptionType(fmt.Sprintf("%T", rec)),
+		sem.ExceptionMessage(msg),
+		sem.ExceptionStacktrace(string(debug.Stack())),
+		sem.ExceptionEscaped(true),
+	))
+	sp.SetStatus(codes.Error, "panic: "+msg)
+}
 func (t *telemetry) HandleRecover(rec any) (any, bool) {
 	switch r := rec.(type) {
 	case nil:
@@ -183,10 +292,7 @@ func (t *telemetry) HandleRecover(rec any) (any, bool) {
 }
 
 func (t *telemetry) SetContext(ctx context.Context) context.Context {
-	if ctx == nil {
-		return nil
-	}
-	return context.WithValue(ctx, ContextKey, t)
+	return SetContext(ctx, t)
 }
 
 // NewTelemetry create Telemetry returns nil if not setup telemetry
@@ -196,12 +302,37 @@ func NewTelemetry(scope string, opts ...trace.SpanStartOption) Telemetry {
 	}
 	return &telemetry{
 		spanStartOption: opts,
-		propagator:      otel.GetTextMapPropagator(),
 		meter:           otel.GetMeterProvider().Meter(scope, metric.WithInstrumentationVersion(Version)),
 		tracer:          otel.GetTracerProvider().Tracer(scope, trace.WithInstrumentationVersion(Version)),
 	}
 }
 
+// scoped Telemetry of scope created after setup, for instruments created before setup
+type scoped struct {
+	scope string
+	t     atomic.Pointer[telemetry]
+}
+
+func (s *scoped) telemetry() *telemetry {
+	if t := s.t.Load(); t != nil {
+		return t
+	}
+	t, ok := NewTelemetry(s.scope).(*telemetry)
+	if !ok {
+		return nil
+	}
+	s.t.CompareAndSwap(nil, t)
+	return s.t.Load()
+}
+
+// fromContext the context telemetry or the scoped one
+func (s *scoped) fromContext(ctx context.Context) *telemetry {
+	if t, ok := FromContext(ctx).(*telemetry); ok {
+		return t
+	}
+	return s.telemetry()
+}
+
 // RuntimeInstrument inject runtime Telemetry
 func RuntimeInstrument(interval time.Duration) {
 	if interval == 0 {
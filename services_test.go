@@ -0,0 +1,69 @@
+package ote
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestContextKey(t *testing.T) {
+	setupTestTelemetry(t)
+	te := NewTelemetry("test")
+	ctx := te.SetContext(context.Background())
+	if FromContext(ctx) != te {
+		t.Fatal("typed key")
+	}
+	if ctx.Value(ContextKey) != nil {
+		t.Fatal("legacy key should not be written")
+	}
+	if FromContext(context.WithValue(ctx, ContextKey, NewTelemetry("shadow"))) != te {
+		t.Fatal("legacy key should not shadow the typed key")
+	}
+	//!! values stored by legacy writers
+	ctx = context.WithValue(context.Background(), ContextKey, te)
+	if r, _ := ByContext(ctx, nil); r != te {
+		t.Fatal("legacy writers")
+	}
+	if FromContext(context.WithValue(context.Background(), "other", te)) != nil {
+		t.Fatal("other key")
+	}
+	other := NewTelemetry("other")
+	if FromContext(SetContext(ctx, other)) != other {
+		t.Fatal("typed key first")
+	}
+}
+
+func TestStartSpanWith(t *testing.T) {
+	rec, _ := setupTestTelemetry(t)
+	te := NewTelemetry("test", trace.WithSpanKind(trace.SpanKindInternal))
+	ctx, parent := te.StartSpan("parent", context.Background())
+	parent.End()
+	producers := make([]context.Context, 3)
+	for i := range producers {
+		producers[i], _ = te.StartSpanWith("producer", context.Background(), trace.WithNewRoot())
+	}
+	at := time.Now().Add(-time.Hour)
+	_, sp := te.StartSpanWith("batch", ctx,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithNewRoot(),
+		trace.WithTimestamp(at),
+		LinkContexts(append(producers, context.Background())...))
+	sp.End()
+	ended := rec.Ended()
+	s := ended[len(ended)-1]
+	switch {
+	case s.SpanKind() != trace.SpanKindConsumer:
+		t.Fatalf("kind %v", s.SpanKind())
+	case s.Parent().IsValid() || s.SpanContext().TraceID() == parent.SpanContext().TraceID():
+		t.Fatalf("new root %v", s.Parent())
+	case !s.StartTime().Equal(at):
+		t.Fatalf("timestamp %v", s.StartTime())
+	case len(s.Links()) != 3 || s.Links()[0].SpanContext.SpanID() != trace.SpanContextFromContext(producers[0]).SpanID():
+		t.Fatalf("links %v", s.Links())
+	}
+	if ended[0].SpanKind() != trace.SpanKindInternal {
+		t.Fatalf("default kind %v", ended[0].SpanKind())
+	}
+}
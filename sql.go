@@ -0,0 +1,526 @@
+package ote
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	sem "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const sqlScope = "github.com/ZenLiuCN/ote/sql"
+
+var (
+	//!! placeholders like $1 are matched to be kept
+	sqlLiteral      = regexp.MustCompile(`'(?:[^']|'')*'|\$\d+|\b\d+(?:\.\d+)?\b`)
+	mysqlLiteral    = regexp.MustCompile(`'(?:[^'\\]|''|\\.)*'|"(?:[^"\\]|""|\\.)*"|\$\d+|\b\d+(?:\.\d+)?\b`)
+	doubleQuotedSQL = []string{"mysql", "mariadb"}
+)
+
+// SanitizeSQL replace string and number literals with ?, double-quoted are identifiers as ANSI SQL
+func SanitizeSQL(query string) string {
+	return sanitizeSQL(sqlLiteral, query)
+}
+
+// sanitizeSQL of system, double-quoted are string literals of mysql
+func sanitizeSQL(literal *regexp.Regexp, query string) string {
+	return literal.ReplaceAllStringFunc(query, func(s string) string {
+		if s[0] == '$' {
+			return s
+		}
+		return "?"
+	})
+}
+
+// WrapDriver wrap d with client spans of Query, Exec, Prepare, Begin, Commit and Rollback.
+// system is the db.system like mysql or postgresql. Spans belong to the context Telemetry if present.
+func WrapDriver(d driver.Driver, system string) driver.Driver {
+	return &sqlDriver{Driver: d, sqlTracer: newSQLTracer(system)}
+}
+
+// OpenDB open db of a registered driver with wrapped driver and register DBStats gauges with system as the pool name
+func OpenDB(driverName, dsn, system string) (*sql.DB, error) {
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	d := db.Driver()
+	_ = db.Close()
+	t := newSQLTracer(system)
+	var c driver.Connector
+	if dc, ok := d.(driver.DriverContext); ok {
+		if c, err = dc.OpenConnector(dsn); err != nil {
+			return nil, err
+		}
+	} else {
+		c = dsnConnector{dsn: dsn, driver: d}
+	}
+	sc := &sqlConnector{Connector: c, driver: &sqlDriver{Driver: d, sqlTracer: t}, sqlTracer: t}
+	db = sql.OpenDB(sc)
+	if sc.stats, err = RegisterDBStats(db, system); err != nil {
+		otel.Handle(err)
+	}
+	return db, nil
+}
+
+// RegisterDBStats register observable metrics of db.Stats with pool name, the registration should be unregistered after db closed.
+// OpenDB unregisters it on db.Close.
+func RegisterDBStats(db *sql.DB, pool string) (metric.Registration, error) {
+	m := otel.GetMeterProvider().Meter(sqlScope, metric.WithInstrumentationVersion(Version))
+	var errs []error
+	gauge := func(name, desc string) metric.Int64ObservableGauge {
+		g, err := m.Int64ObservableGauge(name, metric.WithDescription(desc), metric.WithUnit("{connection}"))
+		errs = append(errs, err)
+		return g
+	}
+	count := gauge(sem.DBClientConnectionCountName, "connections in state used or idle")
+	open := gauge("db.client.connection.open", "established connections")
+	maxOpen := gauge(sem.DBClientConnectionMaxName, "max open connections, 0 is unlimited")
+	waits, err := m.Int64ObservableCounter("db.client.connection.wait_count", metric.WithDescription("connections waited for"))
+	errs = append(errs, err)
+	waited, err := m.Float64ObservableCounter("db.client.connection.wait_duration", metric.WithDescription("time blocked waiting for a connection"), metric.WithUnit("s"))
+	errs = append(errs, err)
+	if err = errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	name := sem.DBClientConnectionsPoolName(pool)
+	used := metric.WithAttributes(name, sem.DBClientConnectionsStateUsed)
+	idle := metric.WithAttributes(name, sem.DBClientConnectionsStateIdle)
+	attrs := metric.WithAttributes(name)
+	return m.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		s := db.Stats()
+		o.ObserveInt64(count, int64(s.InUse), used)
+		o.ObserveInt64(count, int64(s.Idle), idle)
+		o.ObserveInt64(open, int64(s.OpenConnections), attrs)
+		o.ObserveInt64(maxOpen, int64(s.MaxOpenConnections), attrs)
+		o.ObserveInt64(waits, s.WaitCount, attrs)
+		o.ObserveFloat64(waited, s.WaitDuration.Seconds(), attrs)
+		return nil
+	}, count, open, maxOpen, waits, waited)
+}
+
+type sqlTracer struct {
+	*scoped
+	system  attribute.KeyValue
+	literal *regexp.Regexp
+}
+
+func newSQLTracer(system string) *sqlTracer {
+	t := &sqlTracer{scoped: &scoped{scope: sqlScope}, system: sem.DBSystemKey.String(system), literal: sqlLiteral}
+	if slices.Contains(doubleQuotedSQL, system) {
+		t.literal = mysqlLiteral
+	}
+	return t
+}
+
+// start span of operation, query is sanitized, nil span before setup
+func (t *sqlTracer) start(ctx context.Context, operation, query string) (context.Context, trace.Span) {
+	te := t.fromContext(ctx)
+	if te == nil {
+		return ctx, nil
+	}
+	attrs := []attribute.KeyValue{t.system}
+	if query != "" {
+		if f := strings.Fields(query); len(f) > 0 {
+			operation = strings.ToUpper(f[0])
+		}
+		attrs = append(attrs, sem.DBQueryText(sanitizeSQL(t.literal, query)))
+	}
+	attrs = append(attrs, sem.DBOperationName(operation))
+	return te.tracer.Start(ctx, operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// endSQL end span with error, driver.ErrSkip is not an error
+func endSQL(sp trace.Span, err error) {
+	if sp == nil {
+		return
+	}
+	if err != nil && !errors.Is(err, driver.ErrSkip) {
+		sp.RecordError(err)
+		sp.SetStatus(codes.Error, err.Error())
+	}
+	sp.End()
+}
+
+// sqlResult record affected rows of exec
+func sqlResult(sp trace.Span, r driver.Result) {
+	if sp == nil || r == nil {
+		return
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		sp.SetAttributes(attribute.Int64("db.rows_affected", n))
+	}
+}
+
+type sqlDriver struct {
+	driver.Driver
+	*sqlTracer
+}
+
+func (d *sqlDriver) Open(name string) (driver.Conn, error) {
+	c, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlConn{Conn: c, sqlTracer: d.sqlTracer}, nil
+}
+
+func (d *sqlDriver) OpenConnector(name string) (driver.Connector, error) {
+	var c driver.Connector = dsnConnector{dsn: name, driver: d.Driver}
+	if dc, ok := d.Driver.(driver.DriverContext); ok {
+		var err error
+		if c, err = dc.OpenConnector(name); err != nil {
+			return nil, err
+		}
+	}
+	return &sqlConnector{Connector: c, driver: d, sqlTracer: d.sqlTracer}, nil
+}
+
+type dsnConnector struct {
+	dsn    string
+	driver driver.Driver
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+type sqlConnector struct {
+	driver.Connector
+	driver driver.Driver
+	stats  metric.Registration //DBStats of OpenDB
+	*sqlTracer
+}
+
+// Close called by sql.DB.Close
+func (c *sqlConnector) Close() error {
+	var err error
+	if c.stats != nil {
+		err = c.stats.Unregister()
+		c.stats = nil
+	}
+	if x, ok := c.Connector.(io.Closer); ok {
+		err = errors.Join(err, x.Close())
+	}
+	return err
+}
+
+func (c *sqlConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlConn{Conn: conn, sqlTracer: c.sqlTracer}, nil
+}
+
+func (c *sqlConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+type sqlConn struct {
+	driver.Conn
+	*sqlTracer
+}
+
+func (c *sqlConn) PrepareContext(ctx context.Context, query string) (stmt driver.Stmt, err error) {
+	cx, sp := c.start(ctx, "PREPARE", "")
+	if sp != nil {
+		sp.SetAttributes(sem.DBQueryText(sanitizeSQL(c.literal, query)))
+	}
+	defer func() { endSQL(sp, err) }()
+	if p, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		stmt, err = p.PrepareContext(cx, query)
+	} else {
+		stmt, err = c.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	x := &sqlStmt{Stmt: stmt, conn: c.Conn, query: query, sqlTracer: c.sqlTracer}
+	if _, ok := stmt.(driver.ColumnConverter); ok { //nolint:staticcheck
+		return &sqlConverterStmt{x}, nil
+	}
+	return x, nil
+}
+
+func (c *sqlConn) BeginTx(ctx context.Context, opts driver.TxOptions) (tx driver.Tx, err error) {
+	cx, sp := c.start(ctx, "BEGIN", "")
+	defer func() { endSQL(sp, err) }()
+	if b, ok := c.Conn.(driver.ConnBeginTx); ok {
+		tx, err = b.BeginTx(cx, opts)
+	} else if sql.IsolationLevel(opts.Isolation) != sql.LevelDefault {
+		//!! same as database/sql for drivers without ConnBeginTx
+		err = errors.New("sql: driver does not support non-default isolation level")
+	} else if opts.ReadOnly {
+		err = errors.New("sql: driver does not support read-only transactions")
+	} else {
+		tx, err = c.Conn.Begin() //nolint:staticcheck
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sqlTx{Tx: tx, ctx: ctx, sqlTracer: c.sqlTracer}, nil
+}
+
+func (c *sqlConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (r driver.Result, err error) {
+	e, ok := c.Conn.(driver.ExecerContext)
+	legacy, lok := c.Conn.(driver.Execer) //nolint:staticcheck
+	if !ok && !lok {
+		return nil, driver.ErrSkip
+	}
+	cx, sp := c.start(ctx, "", query)
+	defer func() { endSQL(sp, err) }()
+	if ok {
+		r, err = e.ExecContext(cx, query, args)
+	} else {
+		var values []driver.Value
+		if values, err = namedValues(args); err != nil {
+			return nil, err
+		}
+		r, err = legacy.Exec(query, values)
+	}
+	sqlResult(sp, r)
+	return
+}
+
+func (c *sqlConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	q, ok := c.Conn.(driver.QueryerContext)
+	legacy, lok := c.Conn.(driver.Queryer) //nolint:staticcheck
+	if !ok && !lok {
+		return nil, driver.ErrSkip
+	}
+	cx, sp := c.start(ctx, "", query)
+	var rows driver.Rows
+	var err error
+	if ok {
+		rows, err = q.QueryContext(cx, query, args)
+	} else {
+		var values []driver.Value
+		if values, err = namedValues(args); err == nil {
+			rows, err = legacy.Query(query, values)
+		}
+	}
+	if err != nil {
+		endSQL(sp, err)
+		return nil, err
+	}
+	return newSQLRows(rows, sp), nil
+}
+
+func (c *sqlConn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *sqlConn) ResetSession(ctx context.Context) error {
+	if r, ok := c.Conn.(driver.SessionResetter); ok {
+		return r.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *sqlConn) IsValid() bool {
+	if v, ok := c.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
+
+func (c *sqlConn) CheckNamedValue(v *driver.NamedValue) error {
+	if n, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return n.CheckNamedValue(v)
+	}
+	return driver.ErrSkip
+}
+
+type sqlTx struct {
+	driver.Tx
+	ctx context.Context //context of begin
+	*sqlTracer
+}
+
+func (t *sqlTx) Commit() (err error) {
+	_, sp := t.start(t.ctx, "COMMIT", "")
+	defer func() { endSQL(sp, err) }()
+	return t.Tx.Commit()
+}
+
+func (t *sqlTx) Rollback() (err error) {
+	_, sp := t.start(t.ctx, "ROLLBACK", "")
+	defer func() { endSQL(sp, err) }()
+	return t.Tx.Rollback()
+}
+
+type sqlStmt struct {
+	driver.Stmt
+	conn  driver.Conn //checker of arguments when the statement has none
+	query string
+	*sqlTracer
+}
+
+// sqlConverterStmt sqlStmt of driver.ColumnConverter, only exposed when the statement has one
+type sqlConverterStmt struct {
+	*sqlStmt
+}
+
+func (s *sqlConverterStmt) ColumnConverter(idx int) driver.ValueConverter {
+	return s.Stmt.(driver.ColumnConverter).ColumnConverter(idx) //nolint:staticcheck
+}
+
+func (s *sqlStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (r driver.Result, err error) {
+	cx, sp := s.start(ctx, "", s.query)
+	defer func() { endSQL(sp, err) }()
+	if e, ok := s.Stmt.(driver.StmtExecContext); ok {
+		r, err = e.ExecContext(cx, args)
+	} else {
+		var values []driver.Value
+		if values, err = namedValues(args); err != nil {
+			return nil, err
+		}
+		r, err = s.Stmt.Exec(values) //nolint:staticcheck
+	}
+	sqlResult(sp, r)
+	return
+}
+
+func (s *sqlStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	cx, sp := s.start(ctx, "", s.query)
+	var rows driver.Rows
+	var err error
+	if q, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		rows, err = q.QueryContext(cx, args)
+	} else {
+		var values []driver.Value
+		if values, err = namedValues(args); err == nil {
+			rows, err = s.Stmt.Query(values) //nolint:staticcheck
+		}
+	}
+	if err != nil {
+		endSQL(sp, err)
+		return nil, err
+	}
+	return newSQLRows(rows, sp), nil
+}
+
+func (s *sqlStmt) CheckNamedValue(v *driver.NamedValue) error {
+	if n, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return n.CheckNamedValue(v)
+	}
+	if n, ok := s.conn.(driver.NamedValueChecker); ok {
+		return n.CheckNamedValue(v)
+	}
+	return driver.ErrSkip
+}
+
+func namedValues(args []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(args))
+	for i, a := range args {
+		if a.Name != "" {
+			return nil, errors.New("sql: driver does not support named parameters")
+		}
+		values[i] = a.Value
+	}
+	return values, nil
+}
+
+// sqlRows end the query span on close with the returned row count
+type sqlRows struct {
+	driver.Rows
+	span trace.Span
+	rows int64
+	err  error
+}
+
+func newSQLRows(rows driver.Rows, sp trace.Span) driver.Rows {
+	if sp == nil {
+		return rows
+	}
+	return &sqlRows{Rows: rows, span: sp}
+}
+
+func (r *sqlRows) Next(dest []driver.Value) error {
+	err := r.Rows.Next(dest)
+	switch {
+	case err == nil:
+		r.rows++
+	case err != io.EOF:
+		r.err = err
+	}
+	return err
+}
+
+func (r *sqlRows) Close() error {
+	err := r.Rows.Close()
+	if r.span != nil {
+		r.span.SetAttributes(attribute.Int64("db.rows_returned", r.rows))
+		endSQL(r.span, errors.Join(r.err, err))
+		r.span = nil
+	}
+	return err
+}
+
+func (r *sqlRows) HasNextResultSet() bool {
+	n, ok := r.Rows.(driver.RowsNextResultSet)
+	return ok && n.HasNextResultSet()
+}
+
+func (r *sqlRows) NextResultSet() error {
+	if n, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return n.NextResultSet()
+	}
+	return io.EOF
+}
+
+func (r *sqlRows) ColumnTypeDatabaseTypeName(index int) string {
+	if c, ok := r.Rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		return c.ColumnTypeDatabaseTypeName(index)
+	}
+	return ""
+}
+
+//!! column types fallback as database/sql does when the driver lacks them
+
+func (r *sqlRows) ColumnTypeScanType(index int) reflect.Type {
+	if c, ok := r.Rows.(driver.RowsColumnTypeScanType); ok {
+		return c.ColumnTypeScanType(index)
+	}
+	return reflect.TypeOf((*any)(nil)).Elem()
+}
+
+func (r *sqlRows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	if c, ok := r.Rows.(driver.RowsColumnTypeNullable); ok {
+		return c.ColumnTypeNullable(index)
+	}
+	return false, false
+}
+
+func (r *sqlRows) ColumnTypeLength(index int) (length int64, ok bool) {
+	if c, ok := r.Rows.(driver.RowsColumnTypeLength); ok {
+		return c.ColumnTypeLength(index)
+	}
+	return 0, false
+}
+
+func (r *sqlRows) ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool) {
+	if c, ok := r.Rows.(driver.RowsColumnTypePrecisionScale); ok {
+		return c.ColumnTypePrecisionScale(index)
+	}
+	return 0, 0, false
+}
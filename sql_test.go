@@ -0,0 +1,286 @@
+package ote
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"testing"
+)
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return fakeTx{}, nil }
+func (fakeConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(3), nil
+}
+func (fakeConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return &fakeRows{n: 2}, nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error                               { return nil }
+func (fakeStmt) NumInput() int                              { return -1 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(1), nil }
+func (fakeStmt) Query([]driver.Value) (driver.Rows, error)  { return &fakeRows{n: 1}, nil }
+
+type fakeRows struct{ n int }
+
+func (*fakeRows) Columns() []string { return []string{"id"} }
+func (*fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.n == 0 {
+		return io.EOF
+	}
+	r.n--
+	dest[0] = int64(r.n)
+	return nil
+}
+
+func init() {
+	sql.Register("ote-fake", fakeDriver{})
+	sql.Register("ote-fake-wrapped", WrapDriver(fakeDriver{}, "sqlite"))
+	sql.Register("ote-legacy-wrapped", WrapDriver(legacyDriver{}, "sqlite"))
+}
+
+func TestWrapDriver(t *testing.T) {
+	rec, _ := setupTestTelemetry(t)
+	db, err := sql.Open("ote-fake-wrapped", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	ctx := context.Background()
+	r, err := db.ExecContext(ctx, "UPDATE users SET name = 'it''s' WHERE id = 42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := r.RowsAffected(); n != 3 {
+		t.Fatalf("affected %d", n)
+	}
+	rows, err := db.QueryContext(ctx, "select id from users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+	}
+	_ = rows.Close()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := tx.PrepareContext(ctx, "DELETE FROM users WHERE id = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stmt.ExecContext(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	_ = stmt.Close()
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range rec.Ended() {
+		names = append(names, s.Name())
+		if attr(s.Attributes(), "db.system").AsString() != "sqlite" {
+			t.Fatalf("%s attributes %v", s.Name(), s.Attributes())
+		}
+	}
+	want := []string{"UPDATE", "SELECT", "BEGIN", "PREPARE", "DELETE", "COMMIT"}
+	if len(names) != len(want) {
+		t.Fatalf("spans %v", names)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("spans %v", names)
+		}
+	}
+	spans := rec.Ended()
+	switch {
+	case attr(spans[0].Attributes(), "db.query.text").AsString() != "UPDATE users SET name = ? WHERE id = ?":
+		t.Fatalf("sanitized %v", spans[0].Attributes())
+	case attr(spans[0].Attributes(), "db.rows_affected").AsInt64() != 3:
+		t.Fatalf("affected %v", spans[0].Attributes())
+	case attr(spans[1].Attributes(), "db.rows_returned").AsInt64() != 2:
+		t.Fatalf("returned %v", spans[1].Attributes())
+	}
+}
+
+func TestOpenDB(t *testing.T) {
+	rec, reader := setupTestTelemetry(t)
+	db, err := OpenDB("ote-fake", "", "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	if err = db.PingContext(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("INSERT INTO t VALUES (1)"); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Ended()) != 1 {
+		t.Fatalf("spans %d", len(rec.Ended()))
+	}
+	m := metricNames(t, reader)
+	for _, name := range []string{"db.client.connection.count", "db.client.connection.open", "db.client.connection.max", "db.client.connection.wait_count", "db.client.connection.wait_duration"} {
+		if _, ok := m[name]; !ok {
+			t.Errorf("missing %s", name)
+		}
+	}
+}
+
+// legacyConn only the deprecated Execer and Queryer, rows and statement with column types and converter
+type legacyConn struct{}
+
+func (legacyConn) Prepare(string) (driver.Stmt, error) { return legacyStmt{}, nil }
+func (legacyConn) Close() error                        { return nil }
+func (legacyConn) Begin() (driver.Tx, error)           { return fakeTx{}, nil }
+func (legacyConn) Exec(string, []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(5), nil
+}
+func (legacyConn) Query(string, []driver.Value) (driver.Rows, error) {
+	return &typedRows{fakeRows{n: 1}}, nil
+}
+
+type legacyDriver struct{}
+
+func (legacyDriver) Open(string) (driver.Conn, error) { return legacyConn{}, nil }
+
+type legacyStmt struct{ fakeStmt }
+
+func (legacyStmt) NumInput() int { return 1 }
+func (legacyStmt) ColumnConverter(int) driver.ValueConverter {
+	return driver.ValueConverter(doubleConverter{})
+}
+
+type doubleConverter struct{}
+
+func (doubleConverter) ConvertValue(v any) (driver.Value, error) { return v.(int64) * 2, nil }
+
+type typedRows struct{ fakeRows }
+
+func (*typedRows) ColumnTypeScanType(int) reflect.Type               { return reflect.TypeOf(int64(0)) }
+func (*typedRows) ColumnTypeNullable(int) (bool, bool)               { return true, true }
+func (*typedRows) ColumnTypeLength(int) (int64, bool)                { return 8, true }
+func (*typedRows) ColumnTypePrecisionScale(int) (int64, int64, bool) { return 10, 2, true }
+
+func TestWrapDriverLegacy(t *testing.T) {
+	rec, _ := setupTestTelemetry(t)
+	db, err := sql.Open("ote-legacy-wrapped", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	r, err := db.Exec("UPDATE t SET a = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := r.RowsAffected(); n != 5 {
+		t.Fatalf("affected %d", n)
+	}
+	rows, err := db.Query("SELECT id FROM t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = rows.Close()
+	ct := types[0]
+	nullable, _ := ct.Nullable()
+	length, _ := ct.Length()
+	precision, scale, _ := ct.DecimalSize()
+	if ct.ScanType() != reflect.TypeOf(int64(0)) || !nullable || length != 8 || precision != 10 || scale != 2 {
+		t.Fatalf("column type %v %v %v %v %v", ct.ScanType(), nullable, length, precision, scale)
+	}
+	//!! no PREPARE round-trip for the legacy interfaces
+	for _, s := range rec.Ended() {
+		if s.Name() == "PREPARE" {
+			t.Fatal("prepared")
+		}
+	}
+	stmt, err := db.Prepare("SELECT id FROM t WHERE id = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = stmt.Close() }()
+	if _, err = stmt.Exec(int64(2)); err != nil {
+		t.Fatal(err)
+	}
+	ds, _ := (&sqlConn{Conn: legacyConn{}, sqlTracer: newSQLTracer("sqlite")}).PrepareContext(context.Background(), "x")
+	cc, ok := ds.(driver.ColumnConverter)
+	if !ok {
+		t.Fatal("column converter hidden")
+	}
+	if v, _ := cc.ColumnConverter(0).ConvertValue(int64(2)); v != int64(4) {
+		t.Fatalf("converted %v", v)
+	}
+}
+
+func TestOpenDBClose(t *testing.T) {
+	_, reader := setupTestTelemetry(t)
+	db, err := OpenDB("ote-fake", "", "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := metricNames(t, reader)["db.client.connection.open"]; !ok {
+		t.Fatal("stats not registered")
+	}
+	_ = db.Close()
+	if _, ok := metricNames(t, reader)["db.client.connection.open"]; ok {
+		t.Fatal("stats registered after close")
+	}
+}
+
+func TestSanitizeSQL(t *testing.T) {
+	for query, want := range map[string]string{
+		"SELECT * FROM t WHERE id = $1 AND name = 'x' AND n > 10": "SELECT * FROM t WHERE id = $1 AND name = ? AND n > ?",
+		`SELECT "name" FROM "t2" WHERE a = 'it''s'`:               `SELECT "name" FROM "t2" WHERE a = ?`,
+	} {
+		if got := SanitizeSQL(query); got != want {
+			t.Errorf("%s: %s", query, got)
+		}
+	}
+	tr := newSQLTracer("mysql")
+	query := `SELECT * FROM t WHERE a = "x" AND b = 'it\'s' AND c = "say ""hi""" AND d = 1.5`
+	if got := sanitizeSQL(tr.literal, query); got != "SELECT * FROM t WHERE a = ? AND b = ? AND c = ? AND d = ?" {
+		t.Errorf("mysql: %s", got)
+	}
+}
+
+func TestWrapDriverLegacyTxOptions(t *testing.T) {
+	setupTestTelemetry(t)
+	db, err := sql.Open("ote-legacy-wrapped", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	ctx := context.Background()
+	for _, opts := range []*sql.TxOptions{{ReadOnly: true}, {Isolation: sql.LevelSerializable}} {
+		if tx, err := db.BeginTx(ctx, opts); err == nil {
+			_ = tx.Rollback()
+			t.Fatalf("options %+v should not be dropped silently", opts)
+		}
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = tx.Rollback()
+}
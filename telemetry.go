@@ -3,17 +3,22 @@ package ote
 import (
 	"context"
 	"errors"
+	"github.com/ZenLiuCN/ote/config"
 	"github.com/ZenLiuCN/ote/otlp"
 	"github.com/ZenLiuCN/ote/prometheus"
+	"log/slog"
 
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/log"
 	"go.opentelemetry.io/otel/sdk/metric"
-	"go.opentelemetry.io/otel/sdk/trace"
 
 	"go.opentelemetry.io/otel"
 )
 
 var (
-	shutdown func(context.Context) error
+	shutdown       func(context.Context) error
+	tracerProvider *otlp.ReloadableTraceProvider
 )
 
 func HaveTelemetry() bool {
@@ -23,7 +28,18 @@ func SetupTelemetry(ctx context.Context, conf *otlp.TraceConfig) (s func(context
 	if HaveTelemetry() {
 		return shutdown, nil
 	}
+	if conf.Disabled.Valid && conf.Disabled.Bool {
+		return func(context.Context) error { return nil }, nil
+	}
+	if err = conf.Validate(); err != nil {
+		if conf.Strict {
+			return nil, err
+		}
+		slog.Warn("telemetry config invalid, continue with it", "error", err)
+		err = nil
+	}
 	var shutdownFunc []func(context.Context) error
+	metricBaggage.Store(&conf.MetricBaggage)
 
 	shutdown = func(ctx context.Context) error {
 		var err error
@@ -31,30 +47,74 @@ func SetupTelemetry(ctx context.Context, conf *otlp.TraceConfig) (s func(context
 			err = errors.Join(err, fn(ctx))
 		}
 		shutdownFunc = nil
+		//!! instruments of the shutdown providers
+		instruments.Range(func(k, _ any) bool {
+			instruments.Delete(k)
+			return true
+		})
+		metricBaggage.Store(nil)
 		return err
 	}
 	s = shutdown
 	handleErr := func(inErr error) {
 		err = errors.Join(inErr, shutdown(ctx))
 	}
-	prop := NewPropagator()
+	var prop propagation.TextMapPropagator
+	if prop, err = otlp.NewPropagator(conf.Propagators); err != nil {
+		handleErr(err)
+		return
+	}
 	otel.SetTextMapPropagator(prop)
-	var tracerProvider *trace.TracerProvider
-	if tracerProvider, err = otlp.NewTraceProvider(ctx, conf); err != nil {
+	if tracerProvider, err = otlp.NewReloadableTraceProvider(ctx, conf); err != nil {
 		handleErr(err)
 		return
 	} else {
 		shutdownFunc = append(shutdownFunc, tracerProvider.Shutdown)
 		otel.SetTracerProvider(tracerProvider)
 	}
+	var readers []metric.Reader
+	if conf.Metric != nil {
+		var reader metric.Reader
+		if reader, err = otlp.NewMetricReader(ctx, conf.Metric); err != nil {
+			handleErr(err)
+			return
+		}
+		readers = append(readers, reader)
+	}
 	var meterProvider *metric.MeterProvider
-	if meterProvider, err = prometheus.NewMeterProvider(ctx, conf.Config); err != nil {
+	if meterProvider, err = prometheus.NewMeterProvider(ctx, conf.Config, readers...); err != nil {
+		//!! push readers are not owned by any provider yet
+		for _, reader := range readers {
+			err = errors.Join(err, reader.Shutdown(ctx))
+		}
 		handleErr(err)
 		return
 	} else {
 		shutdownFunc = append(shutdownFunc, meterProvider.Shutdown)
 		otel.SetMeterProvider(meterProvider)
 	}
+	if conf.Log != nil {
+		var loggerProvider *log.LoggerProvider
+		if loggerProvider, err = otlp.NewLoggerProvider(ctx, conf.Log, conf.Config); err != nil {
+			handleErr(err)
+			return
+		}
+		shutdownFunc = append(shutdownFunc, loggerProvider.Shutdown)
+		global.SetLoggerProvider(loggerProvider)
+	}
 
 	return
 }
+
+// SetupTelemetryFromFile setup telemetry with declarative config file of yaml or json, see config.Document
+func SetupTelemetryFromFile(ctx context.Context, path string) (s func(context.Context) error, err error) {
+	doc, err := config.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	conf, err := doc.TraceConfig()
+	if err != nil {
+		return nil, err
+	}
+	return SetupTelemetry(ctx, conf)
+}
@@ -0,0 +1,75 @@
+package ote
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/ZenLiuCN/ote/otlp"
+	"github.com/ZenLiuCN/ote/resource"
+)
+
+func TestSetupTelemetryStrict(t *testing.T) {
+	ctx := context.Background()
+	srv, _ := traceCollector(t)
+	invalid := func(strict bool) *otlp.TraceConfig {
+		return &otlp.TraceConfig{
+			Protocol: otlp.ProtocolHTTP,
+			Endpoint: srv.URL,
+			Insecure: sql.NullBool{Bool: true, Valid: true},
+			Tail:     &otlp.TailConfig{Ratio: sql.NullFloat64{Float64: 2, Valid: true}},
+			Strict:   strict,
+			Config:   &resource.Config{},
+		}
+	}
+	s, err := SetupTelemetry(ctx, invalid(true))
+	var fields resource.FieldErrors
+	if s != nil || !errors.As(err, &fields) || fields[0].Path != "Tail.Ratio" {
+		t.Fatalf("strict should refuse to start: %v", err)
+	}
+	if HaveTelemetry() {
+		t.Fatal("strict setup should not install telemetry")
+	}
+	s, err = SetupTelemetry(ctx, invalid(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = s(ctx)
+		shutdown, tracerProvider = nil, nil
+	})
+	if !HaveTelemetry() {
+		t.Fatal("non-strict setup should continue")
+	}
+}
+
+func TestShutdownClearsInstruments(t *testing.T) {
+	ctx := context.Background()
+	srv, _ := traceCollector(t)
+	s, err := SetupTelemetry(ctx, &otlp.TraceConfig{
+		Protocol:      otlp.ProtocolHTTP,
+		Endpoint:      srv.URL,
+		Insecure:      sql.NullBool{Bool: true, Valid: true},
+		MetricBaggage: []string{"tenant"},
+		Config:        &resource.Config{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { shutdown, tracerProvider = nil, nil }()
+	NewTelemetry("test").Counter("calls", "{call}").Add(ctx, 1)
+	if keys := metricBaggage.Load(); keys == nil || (*keys)[0] != "tenant" {
+		t.Fatalf("metric baggage %v", keys)
+	}
+	if err = s(ctx); err != nil {
+		t.Fatal(err)
+	}
+	instruments.Range(func(k, _ any) bool {
+		t.Fatalf("instrument %v cached after shutdown", k)
+		return false
+	})
+	if metricBaggage.Load() != nil {
+		t.Fatal("metric baggage kept after shutdown")
+	}
+}
@@ -0,0 +1,190 @@
+package ote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	sem "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Transport instrumented http.RoundTripper, each sent request is a client span of the request context Telemetry
+// (or the Telemetry of Scope) with trace headers injected. A redirected request is the child span of the previous hop,
+// a retry is the child span of the previous attempt.
+type Transport struct {
+	Base    http.RoundTripper //default http.DefaultTransport
+	Scope   string            //scope of Telemetry when request context has none
+	Retry   int               //max retries of replayable idempotent requests on network error or 502|503|504, 0 disabled
+	Backoff time.Duration     //wait before retry, default 100ms
+	once    sync.Once
+	scoped  *scoped
+}
+
+// NewTransport create Transport of scope over base, nil base means http.DefaultTransport
+func NewTransport(scope string, base http.RoundTripper) *Transport {
+	return &Transport{Base: base, Scope: scope}
+}
+
+// NewClient create http.Client with Transport of scope
+func NewClient(scope string) *http.Client {
+	return &http.Client{Transport: NewTransport(scope, nil)}
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// telemetry of Scope created at the first request after setup
+func (t *Transport) telemetry() *telemetry {
+	t.once.Do(func() { t.scoped = &scoped{scope: t.Scope} })
+	return t.scoped.telemetry()
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	i := t.telemetry()
+	if i == nil {
+		return t.base().RoundTrip(req)
+	}
+	tel := t.scoped.fromContext(req.Context())
+	//!! redirect follows the previous hop
+	parent, resend := req.Context(), 0
+	for r := req.Response; r != nil && r.Request != nil; r = r.Request.Response {
+		if resend == 0 {
+			parent = r.Request.Context()
+		}
+		resend++
+	}
+	for attempt := 0; ; attempt++ {
+		attrs := clientAttributes(req)
+		if n := resend + attempt; n > 0 {
+			attrs = append(attrs, sem.HTTPRequestResendCount(n))
+		}
+		start := time.Now()
+		ctx, sp := tel.tracer.Start(parent, req.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+		r := req.Clone(ctx)
+		if attempt > 0 && req.GetBody != nil {
+			r.Body, _ = req.GetBody()
+		}
+		tel.propagator().Inject(ctx, propagation.HeaderCarrier(r.Header))
+		res, err := t.base().RoundTrip(r)
+		mAttrs := attrs[:4:4]
+		switch {
+		case err != nil:
+			sp.RecordError(err)
+			sp.SetStatus(codes.Error, err.Error())
+			mAttrs = append(mAttrs, sem.ErrorTypeKey.String(errorType(err)))
+		default:
+			if res.Request == nil {
+				res.Request = r
+			}
+			mAttrs = append(mAttrs, sem.HTTPResponseStatusCode(res.StatusCode))
+			if res.StatusCode >= 400 {
+				sp.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+				mAttrs = append(mAttrs, sem.ErrorTypeKey.String(strconv.Itoa(res.StatusCode)))
+			}
+			if res.ProtoMajor > 0 {
+				sp.SetAttributes(sem.NetworkProtocolVersion(strings.TrimPrefix(res.Proto, "HTTP/")))
+			}
+			if res.ContentLength > 0 {
+				sp.SetAttributes(sem.HTTPResponseBodySize(int(res.ContentLength)))
+			}
+		}
+		sp.SetAttributes(mAttrs[4:]...)
+		sp.End()
+		i.requestDuration(sem.HTTPClientRequestDurationName, sem.HTTPClientRequestDurationUnit, sem.HTTPClientRequestDurationDescription).
+			Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(mAttrs...))
+		if attempt >= t.Retry || !retryable(req, res, err) {
+			return res, err
+		}
+		if res != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+			_ = res.Body.Close()
+		}
+		if err = t.wait(req.Context()); err != nil {
+			return nil, err
+		}
+		parent = ctx
+	}
+}
+
+func (t *Transport) wait(ctx context.Context) error {
+	d := t.Backoff
+	if d <= 0 {
+		d = 100 * time.Millisecond
+	}
+	w := time.NewTimer(d)
+	defer w.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-w.C:
+		return nil
+	}
+}
+
+// retryable idempotent request which body can be replayed, failed by network or a temporary gateway status
+func retryable(req *http.Request, res *http.Response, err error) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+	default:
+		if req.Header.Get("Idempotency-Key") == "" {
+			return false
+		}
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// clientAttributes the first 4 are also metric attributes
+func clientAttributes(r *http.Request) []attribute.KeyValue {
+	host, port := hostPort(r.URL.Host)
+	if port == 0 {
+		port = 80
+		if r.URL.Scheme == "https" {
+			port = 443
+		}
+	}
+	u := *r.URL
+	u.User = nil
+	return []attribute.KeyValue{
+		httpMethod(r.Method),
+		sem.ServerAddress(host),
+		sem.ServerPort(port),
+		sem.URLScheme(r.URL.Scheme),
+		sem.URLFull(u.String()),
+	}
+}
+
+// errorType the go type of error like *net.OpError
+func errorType(err error) string {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	}
+	return fmt.Sprintf("%T", err)
+}
@@ -0,0 +1,88 @@
+package ote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTransport(t *testing.T) {
+	rec, reader := setupTestTelemetry(t)
+	var failures atomic.Int32
+	failures.Store(1)
+	var traceparent atomic.Value
+	mux := http.NewServeMux()
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new", http.StatusFound)
+	})
+	mux.HandleFunc("/new", func(w http.ResponseWriter, r *http.Request) {
+		traceparent.Store(r.Header.Get("traceparent"))
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/flaky", func(w http.ResponseWriter, r *http.Request) {
+		if failures.Add(-1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	client := &http.Client{Transport: &Transport{Scope: "test", Retry: 2, Backoff: time.Millisecond}}
+
+	te := NewTelemetry("caller")
+	ctx, root := te.StartSpan("root", te.SetContext(context.Background()))
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/old", nil)
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/flaky", nil)
+	if res, err = client.Do(req); err != nil || res.StatusCode != http.StatusNoContent {
+		t.Fatal(res, err)
+	}
+	_ = res.Body.Close()
+	root.End()
+
+	spans := rec.Ended()
+	if len(spans) != 5 {
+		t.Fatalf("spans %d", len(spans))
+	}
+	first, redirect, try, retry := spans[0], spans[1], spans[2], spans[3]
+	switch {
+	case first.SpanKind() != trace.SpanKindClient || first.Name() != "GET" || first.Parent().SpanID() != root.SpanContext().SpanID():
+		t.Fatalf("first %s %s %v", first.Name(), first.SpanKind(), first.Parent())
+	case redirect.Parent().SpanID() != first.SpanContext().SpanID() || attr(redirect.Attributes(), "http.request.resend_count").AsInt64() != 1:
+		t.Fatalf("redirect %v %v", redirect.Parent(), redirect.Attributes())
+	case traceparent.Load() != "00-"+redirect.SpanContext().TraceID().String()+"-"+redirect.SpanContext().SpanID().String()+"-01":
+		t.Fatalf("traceparent %v", traceparent.Load())
+	case try.Status().Code != codes.Error || attr(try.Attributes(), "http.response.status_code").AsInt64() != 503:
+		t.Fatalf("try %v %v", try.Status(), try.Attributes())
+	case retry.Parent().SpanID() != try.SpanContext().SpanID() || retry.Status().Code == codes.Error:
+		t.Fatalf("retry %v %v", retry.Parent(), retry.Status())
+	}
+	if _, ok := metricNames(t, reader)["http.client.request.duration"]; !ok {
+		t.Fatal("missing duration histogram")
+	}
+}
+
+func TestTransportWithoutTelemetry(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("traceparent") != "" {
+			t.Error("should pass through before setup")
+		}
+	}))
+	defer srv.Close()
+	res, err := NewClient("test").Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+}
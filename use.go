@@ -1,19 +1,26 @@
+// Code generated by ote-gen; DO NOT EDIT.
+
 package ote
 
 import (
 	"context"
 	"errors"
 	"fmt"
+
 	"go.opentelemetry.io/otel/attribute"
 )
 
 // Use00 add span when context not nil
-func Use00(fn func(context.Context), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) {
+func Use00(fn func(context.Context), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -25,14 +32,16 @@ func Use00(fn func(context.Context), pp TelemetryProviderFn, sp SpanProviderFn)
 }
 
 // UseErr00 add span when context not nil
-func UseErr00(fn func(context.Context) error, pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) error {
+func UseErr00(fn func(context.Context) error, pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -41,7 +50,10 @@ func UseErr00(fn func(context.Context) error, pp TelemetryProviderFn, sp SpanPro
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx)
 		} else {
@@ -52,12 +64,16 @@ func UseErr00(fn func(context.Context) error, pp TelemetryProviderFn, sp SpanPro
 }
 
 // UseOption00 add span when context contains Telemetry
-func UseOption00(fn func(context.Context), sp SpanProviderFn) func(context.Context) {
+func UseOption00(fn func(context.Context), sp SpanProviderFn, opts ...WrapOption) func(context.Context) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -69,14 +85,16 @@ func UseOption00(fn func(context.Context), sp SpanProviderFn) func(context.Conte
 }
 
 // UseOptionErr00 add span when context contains Telemetry
-func UseOptionErr00(fn func(context.Context) error, sp SpanProviderFn) func(context.Context) error {
+func UseOptionErr00(fn func(context.Context) error, sp SpanProviderFn, opts ...WrapOption) func(context.Context) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -85,7 +103,10 @@ func UseOptionErr00(fn func(context.Context) error, sp SpanProviderFn) func(cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx)
 		} else {
@@ -96,12 +117,19 @@ func UseOptionErr00(fn func(context.Context) error, sp SpanProviderFn) func(cont
 }
 
 // Use01 add span when context not nil
-func Use01[R1 any](fn func(context.Context) R1, pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) R1 {
+func Use01[R1 any](fn func(context.Context) R1, pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -114,14 +142,16 @@ func Use01[R1 any](fn func(context.Context) R1, pp TelemetryProviderFn, sp SpanP
 }
 
 // UseErr01 add span when context not nil
-func UseErr01[R1 any](fn func(context.Context) (R1, error), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, error) {
+func UseErr01[R1 any](fn func(context.Context) (R1, error), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -130,7 +160,10 @@ func UseErr01[R1 any](fn func(context.Context) (R1, error), pp TelemetryProvider
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx)
 		} else {
@@ -141,12 +174,19 @@ func UseErr01[R1 any](fn func(context.Context) (R1, error), pp TelemetryProvider
 }
 
 // UseOption01 add span when context contains Telemetry
-func UseOption01[R1 any](fn func(context.Context) R1, sp SpanProviderFn) func(context.Context) R1 {
+func UseOption01[R1 any](fn func(context.Context) R1, sp SpanProviderFn, opts ...WrapOption) func(context.Context) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -159,14 +199,16 @@ func UseOption01[R1 any](fn func(context.Context) R1, sp SpanProviderFn) func(co
 }
 
 // UseOptionErr01 add span when context contains Telemetry
-func UseOptionErr01[R1 any](fn func(context.Context) (R1, error), sp SpanProviderFn) func(context.Context) (R1, error) {
+func UseOptionErr01[R1 any](fn func(context.Context) (R1, error), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -175,7 +217,10 @@ func UseOptionErr01[R1 any](fn func(context.Context) (R1, error), sp SpanProvide
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx)
 		} else {
@@ -186,12 +231,19 @@ func UseOptionErr01[R1 any](fn func(context.Context) (R1, error), sp SpanProvide
 }
 
 // Use02 add span when context not nil
-func Use02[R1, R2 any](fn func(context.Context) (R1, R2), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2) {
+func Use02[R1, R2 any](fn func(context.Context) (R1, R2), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -204,14 +256,16 @@ func Use02[R1, R2 any](fn func(context.Context) (R1, R2), pp TelemetryProviderFn
 }
 
 // UseErr02 add span when context not nil
-func UseErr02[R1, R2 any](fn func(context.Context) (R1, R2, error), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, error) {
+func UseErr02[R1, R2 any](fn func(context.Context) (R1, R2, error), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -220,7 +274,10 @@ func UseErr02[R1, R2 any](fn func(context.Context) (R1, R2, error), pp Telemetry
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx)
 		} else {
@@ -231,12 +288,19 @@ func UseErr02[R1, R2 any](fn func(context.Context) (R1, R2, error), pp Telemetry
 }
 
 // UseOption02 add span when context contains Telemetry
-func UseOption02[R1, R2 any](fn func(context.Context) (R1, R2), sp SpanProviderFn) func(context.Context) (R1, R2) {
+func UseOption02[R1, R2 any](fn func(context.Context) (R1, R2), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -249,14 +313,16 @@ func UseOption02[R1, R2 any](fn func(context.Context) (R1, R2), sp SpanProviderF
 }
 
 // UseOptionErr02 add span when context contains Telemetry
-func UseOptionErr02[R1, R2 any](fn func(context.Context) (R1, R2, error), sp SpanProviderFn) func(context.Context) (R1, R2, error) {
+func UseOptionErr02[R1, R2 any](fn func(context.Context) (R1, R2, error), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -265,7 +331,10 @@ func UseOptionErr02[R1, R2 any](fn func(context.Context) (R1, R2, error), sp Spa
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx)
 		} else {
@@ -276,12 +345,19 @@ func UseOptionErr02[R1, R2 any](fn func(context.Context) (R1, R2, error), sp Spa
 }
 
 // Use03 add span when context not nil
-func Use03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3) {
+func Use03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -294,14 +370,16 @@ func Use03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3), pp TelemetryPr
 }
 
 // UseErr03 add span when context not nil
-func UseErr03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3, error), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, error) {
+func UseErr03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3, error), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -310,7 +388,10 @@ func UseErr03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3, error), pp T
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx)
 		} else {
@@ -321,12 +402,19 @@ func UseErr03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3, error), pp T
 }
 
 // UseOption03 add span when context contains Telemetry
-func UseOption03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3), sp SpanProviderFn) func(context.Context) (R1, R2, R3) {
+func UseOption03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -339,14 +427,16 @@ func UseOption03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3), sp SpanP
 }
 
 // UseOptionErr03 add span when context contains Telemetry
-func UseOptionErr03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3, error), sp SpanProviderFn) func(context.Context) (R1, R2, R3, error) {
+func UseOptionErr03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3, error), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -355,7 +445,10 @@ func UseOptionErr03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3, error)
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx)
 		} else {
@@ -366,12 +459,19 @@ func UseOptionErr03[R1, R2, R3 any](fn func(context.Context) (R1, R2, R3, error)
 }
 
 // Use04 add span when context not nil
-func Use04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4) {
+func Use04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -384,14 +484,16 @@ func Use04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4), pp Tel
 }
 
 // UseErr04 add span when context not nil
-func UseErr04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, error) {
+func UseErr04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -400,7 +502,10 @@ func UseErr04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4, erro
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx)
 		} else {
@@ -411,12 +516,19 @@ func UseErr04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4, erro
 }
 
 // UseOption04 add span when context contains Telemetry
-func UseOption04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4) {
+func UseOption04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -429,14 +541,16 @@ func UseOption04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4),
 }
 
 // UseOptionErr04 add span when context contains Telemetry
-func UseOptionErr04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4, error), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, error) {
+func UseOptionErr04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4, error), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -445,7 +559,10 @@ func UseOptionErr04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx)
 		} else {
@@ -456,12 +573,19 @@ func UseOptionErr04[R1, R2, R3, R4 any](fn func(context.Context) (R1, R2, R3, R4
 }
 
 // Use05 add span when context not nil
-func Use05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5) {
+func Use05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -474,14 +598,16 @@ func Use05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4, R5)
 }
 
 // UseErr05 add span when context not nil
-func UseErr05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, error) {
+func UseErr05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -490,7 +616,10 @@ func UseErr05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx)
 		} else {
@@ -501,12 +630,19 @@ func UseErr05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4,
 }
 
 // UseOption05 add span when context contains Telemetry
-func UseOption05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4, R5), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5) {
+func UseOption05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4, R5), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -519,14 +655,16 @@ func UseOption05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R
 }
 
 // UseOptionErr05 add span when context contains Telemetry
-func UseOptionErr05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4, R5, error), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, error) {
+func UseOptionErr05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3, R4, R5, error), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -535,7 +673,10 @@ func UseOptionErr05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx)
 		} else {
@@ -546,12 +687,19 @@ func UseOptionErr05[R1, R2, R3, R4, R5 any](fn func(context.Context) (R1, R2, R3
 }
 
 // Use06 add span when context not nil
-func Use06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6) {
+func Use06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -564,14 +712,16 @@ func Use06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3, R4,
 }
 
 // UseErr06 add span when context not nil
-func UseErr06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, error) {
+func UseErr06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -580,7 +730,10 @@ func UseErr06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx)
 		} else {
@@ -591,12 +744,19 @@ func UseErr06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3,
 }
 
 // UseOption06 add span when context contains Telemetry
-func UseOption06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6) {
+func UseOption06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -609,14 +769,16 @@ func UseOption06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R
 }
 
 // UseOptionErr06 add span when context contains Telemetry
-func UseOptionErr06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, error), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, error) {
+func UseOptionErr06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, error), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -625,7 +787,10 @@ func UseOptionErr06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx)
 		} else {
@@ -636,12 +801,19 @@ func UseOptionErr06[R1, R2, R3, R4, R5, R6 any](fn func(context.Context) (R1, R2
 }
 
 // Use07 add span when context not nil
-func Use07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7) {
+func Use07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -654,14 +826,16 @@ func Use07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2, R3,
 }
 
 // UseErr07 add span when context not nil
-func UseErr07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -670,7 +844,10 @@ func UseErr07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx)
 		} else {
@@ -681,12 +858,19 @@ func UseErr07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2,
 }
 
 // UseOption07 add span when context contains Telemetry
-func UseOption07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7) {
+func UseOption07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -699,14 +883,16 @@ func UseOption07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R
 }
 
 // UseOptionErr07 add span when context contains Telemetry
-func UseOptionErr07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, error), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseOptionErr07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, error), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -715,7 +901,10 @@ func UseOptionErr07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx)
 		} else {
@@ -726,12 +915,19 @@ func UseOptionErr07[R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context) (R1
 }
 
 // Use08 add span when context not nil
-func Use08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -744,14 +940,16 @@ func Use08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1, R2,
 }
 
 // UseErr08 add span when context not nil
-func UseErr08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -760,7 +958,10 @@ func UseErr08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx)
 		} else {
@@ -771,12 +972,19 @@ func UseErr08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1,
 }
 
 // UseOption08 add span when context contains Telemetry
-func UseOption08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func UseOption08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -789,14 +997,16 @@ func UseOption08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R
 }
 
 // UseOptionErr08 add span when context contains Telemetry
-func UseOptionErr08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseOptionErr08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -805,7 +1015,10 @@ func UseOptionErr08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context)
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx)
 		} else {
@@ -816,12 +1029,19 @@ func UseOptionErr08[R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context)
 }
 
 // Use09 add span when context not nil
-func Use09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func Use09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -834,14 +1054,16 @@ func Use09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (R1,
 }
 
 // UseErr09 add span when context not nil
-func UseErr09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
-		if t, s, cx := SpanByContext(ctx, pp, sp); s != nil {
+		if t, cx := ByContext(ctx, pp); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -850,7 +1072,10 @@ func UseErr09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx)
 		} else {
@@ -861,12 +1086,19 @@ func UseErr09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (
 }
 
 // UseOption09 add span when context contains Telemetry
-func UseOption09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func UseOption09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -879,14 +1111,16 @@ func UseOption09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context
 }
 
 // UseOptionErr09 add span when context contains Telemetry
-func UseOptionErr09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp SpanProviderFn) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseOptionErr09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp SpanProviderFn, opts ...WrapOption) func(context.Context) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
-		if t, s, cx := SpanFromContext(ctx, sp); s != nil {
+		if t := FromContext(ctx); t != nil {
+			n, a := sp.span()
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -895,7 +1129,10 @@ func UseOptionErr09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx)
 		} else {
@@ -906,14 +1143,16 @@ func UseOptionErr09[R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Cont
 }
 
 // Use10 add span when context not nil
-func Use10[A1 any](fn func(context.Context, A1), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) {
+func Use10[A1 any](fn func(context.Context, A1), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -925,16 +1164,16 @@ func Use10[A1 any](fn func(context.Context, A1), pp TelemetryProviderFn, sp func
 }
 
 // UseErr10 add span when context not nil
-func UseErr10[A1 any](fn func(context.Context, A1) error, pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) error {
+func UseErr10[A1 any](fn func(context.Context, A1) error, pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -943,7 +1182,10 @@ func UseErr10[A1 any](fn func(context.Context, A1) error, pp TelemetryProviderFn
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1)
 		} else {
@@ -954,14 +1196,16 @@ func UseErr10[A1 any](fn func(context.Context, A1) error, pp TelemetryProviderFn
 }
 
 // UseOption10 add span when context contains Telemetry
-func UseOption10[A1 any](fn func(context.Context, A1), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) {
+func UseOption10[A1 any](fn func(context.Context, A1), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -973,16 +1217,16 @@ func UseOption10[A1 any](fn func(context.Context, A1), sp func(A1) (string, []at
 }
 
 // UseOptionErr10 add span when context contains Telemetry
-func UseOptionErr10[A1 any](fn func(context.Context, A1) error, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) error {
+func UseOptionErr10[A1 any](fn func(context.Context, A1) error, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -991,7 +1235,10 @@ func UseOptionErr10[A1 any](fn func(context.Context, A1) error, sp func(A1) (str
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1)
 		} else {
@@ -1002,14 +1249,19 @@ func UseOptionErr10[A1 any](fn func(context.Context, A1) error, sp func(A1) (str
 }
 
 // Use11 add span when context not nil
-func Use11[A1, R1 any](fn func(context.Context, A1) R1, pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) R1 {
+func Use11[A1, R1 any](fn func(context.Context, A1) R1, pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1022,16 +1274,16 @@ func Use11[A1, R1 any](fn func(context.Context, A1) R1, pp TelemetryProviderFn,
 }
 
 // UseErr11 add span when context not nil
-func UseErr11[A1, R1 any](fn func(context.Context, A1) (R1, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, error) {
+func UseErr11[A1, R1 any](fn func(context.Context, A1) (R1, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1040,7 +1292,10 @@ func UseErr11[A1, R1 any](fn func(context.Context, A1) (R1, error), pp Telemetry
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1)
 		} else {
@@ -1050,15 +1305,20 @@ func UseErr11[A1, R1 any](fn func(context.Context, A1) (R1, error), pp Telemetry
 	}
 }
 
-// UseOption11 add span when context have a Telemetry
-func UseOption11[A1, R1 any](fn func(context.Context, A1) R1, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) R1 {
+// UseOption11 add span when context contains Telemetry
+func UseOption11[A1, R1 any](fn func(context.Context, A1) R1, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1070,17 +1330,17 @@ func UseOption11[A1, R1 any](fn func(context.Context, A1) R1, sp func(A1) (strin
 	}
 }
 
-// UseOptionErr11 add span when context have a Telemetry
-func UseOptionErr11[A1, R1 any](fn func(context.Context, A1) (R1, error), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, error) {
+// UseOptionErr11 add span when context contains Telemetry
+func UseOptionErr11[A1, R1 any](fn func(context.Context, A1) (R1, error), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1089,7 +1349,10 @@ func UseOptionErr11[A1, R1 any](fn func(context.Context, A1) (R1, error), sp fun
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1)
 		} else {
@@ -1100,14 +1363,19 @@ func UseOptionErr11[A1, R1 any](fn func(context.Context, A1) (R1, error), sp fun
 }
 
 // Use12 add span when context not nil
-func Use12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2) {
+func Use12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1120,16 +1388,16 @@ func Use12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2), pp TelemetryPr
 }
 
 // UseErr12 add span when context not nil
-func UseErr12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, error) {
+func UseErr12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1138,7 +1406,10 @@ func UseErr12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2, error), pp T
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1)
 		} else {
@@ -1148,15 +1419,20 @@ func UseErr12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2, error), pp T
 	}
 }
 
-// UseOption12 add span when context have a Telemetry
-func UseOption12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2) {
+// UseOption12 add span when context contains Telemetry
+func UseOption12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1168,17 +1444,17 @@ func UseOption12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2), sp func(
 	}
 }
 
-// UseOptionErr12 add span when context have a Telemetry
-func UseOptionErr12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2, error), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, error) {
+// UseOptionErr12 add span when context contains Telemetry
+func UseOptionErr12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2, error), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1187,7 +1463,10 @@ func UseOptionErr12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2, error)
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1)
 		} else {
@@ -1198,14 +1477,19 @@ func UseOptionErr12[A1, R1, R2 any](fn func(context.Context, A1) (R1, R2, error)
 }
 
 // Use13 add span when context not nil
-func Use13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3) {
+func Use13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1218,16 +1502,16 @@ func Use13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3), pp Tel
 }
 
 // UseErr13 add span when context not nil
-func UseErr13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, error) {
+func UseErr13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1236,7 +1520,10 @@ func UseErr13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3, erro
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1)
 		} else {
@@ -1246,15 +1533,20 @@ func UseErr13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3, erro
 	}
 }
 
-// UseOption13 add span when context have a Telemetry
-func UseOption13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3) {
+// UseOption13 add span when context contains Telemetry
+func UseOption13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1266,17 +1558,17 @@ func UseOption13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3),
 	}
 }
 
-// UseOptionErr13 add span when context have a Telemetry
-func UseOptionErr13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3, error), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, error) {
+// UseOptionErr13 add span when context contains Telemetry
+func UseOptionErr13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3, error), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1285,7 +1577,10 @@ func UseOptionErr13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1)
 		} else {
@@ -1296,14 +1591,19 @@ func UseOptionErr13[A1, R1, R2, R3 any](fn func(context.Context, A1) (R1, R2, R3
 }
 
 // Use14 add span when context not nil
-func Use14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4) {
+func Use14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1316,16 +1616,16 @@ func Use14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3, R4)
 }
 
 // UseErr14 add span when context not nil
-func UseErr14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, error) {
+func UseErr14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1334,7 +1634,10 @@ func UseErr14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1)
 		} else {
@@ -1344,15 +1647,20 @@ func UseErr14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3,
 	}
 }
 
-// UseOption14 add span when context have a Telemetry
-func UseOption14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3, R4), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4) {
+// UseOption14 add span when context contains Telemetry
+func UseOption14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3, R4), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1364,17 +1672,17 @@ func UseOption14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R
 	}
 }
 
-// UseOptionErr14 add span when context have a Telemetry
-func UseOptionErr14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3, R4, error), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, error) {
+// UseOptionErr14 add span when context contains Telemetry
+func UseOptionErr14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2, R3, R4, error), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1383,7 +1691,10 @@ func UseOptionErr14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1)
 		} else {
@@ -1394,14 +1705,19 @@ func UseOptionErr14[A1, R1, R2, R3, R4 any](fn func(context.Context, A1) (R1, R2
 }
 
 // Use15 add span when context not nil
-func Use15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5) {
+func Use15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1414,16 +1730,16 @@ func Use15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2, R3,
 }
 
 // UseErr15 add span when context not nil
-func UseErr15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, error) {
+func UseErr15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1432,7 +1748,10 @@ func UseErr15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1)
 		} else {
@@ -1442,15 +1761,20 @@ func UseErr15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2,
 	}
 }
 
-// UseOption15 add span when context have a Telemetry
-func UseOption15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5) {
+// UseOption15 add span when context contains Telemetry
+func UseOption15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1462,17 +1786,17 @@ func UseOption15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R
 	}
 }
 
-// UseOptionErr15 add span when context have a Telemetry
-func UseOptionErr15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, error), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, error) {
+// UseOptionErr15 add span when context contains Telemetry
+func UseOptionErr15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, error), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1481,7 +1805,10 @@ func UseOptionErr15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1)
 		} else {
@@ -1492,14 +1819,19 @@ func UseOptionErr15[A1, R1, R2, R3, R4, R5 any](fn func(context.Context, A1) (R1
 }
 
 // Use16 add span when context not nil
-func Use16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6) {
+func Use16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1512,16 +1844,16 @@ func Use16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1, R2,
 }
 
 // UseErr16 add span when context not nil
-func UseErr16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, error) {
+func UseErr16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1530,7 +1862,10 @@ func UseErr16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1)
 		} else {
@@ -1540,15 +1875,20 @@ func UseErr16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1,
 	}
 }
 
-// UseOption16 add span when context have a Telemetry
-func UseOption16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6) {
+// UseOption16 add span when context contains Telemetry
+func UseOption16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1560,17 +1900,17 @@ func UseOption16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R
 	}
 }
 
-// UseOptionErr16 add span when context have a Telemetry
-func UseOptionErr16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, error), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, error) {
+// UseOptionErr16 add span when context contains Telemetry
+func UseOptionErr16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, error), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1579,7 +1919,10 @@ func UseOptionErr16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1)
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1)
 		} else {
@@ -1590,14 +1933,19 @@ func UseOptionErr16[A1, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1)
 }
 
 // Use17 add span when context not nil
-func Use17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7) {
+func Use17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1610,16 +1958,16 @@ func Use17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (R1,
 }
 
 // UseErr17 add span when context not nil
-func UseErr17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1628,7 +1976,10 @@ func UseErr17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1)
 		} else {
@@ -1638,15 +1989,20 @@ func UseErr17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (
 	}
 }
 
-// UseOption17 add span when context have a Telemetry
-func UseOption17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7) {
+// UseOption17 add span when context contains Telemetry
+func UseOption17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1658,17 +2014,17 @@ func UseOption17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1
 	}
 }
 
-// UseOptionErr17 add span when context have a Telemetry
-func UseOptionErr17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, error) {
+// UseOptionErr17 add span when context contains Telemetry
+func UseOptionErr17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1677,7 +2033,10 @@ func UseOptionErr17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1)
 		} else {
@@ -1688,14 +2047,19 @@ func UseOptionErr17[A1, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context,
 }
 
 // Use18 add span when context not nil
-func Use18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1708,16 +2072,16 @@ func Use18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1)
 }
 
 // UseErr18 add span when context not nil
-func UseErr18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1726,7 +2090,10 @@ func UseErr18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1)
 		} else {
@@ -1736,15 +2103,20 @@ func UseErr18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A
 	}
 }
 
-// UseOption18 add span when context have a Telemetry
-func UseOption18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8) {
+// UseOption18 add span when context contains Telemetry
+func UseOption18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1756,17 +2128,17 @@ func UseOption18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context
 	}
 }
 
-// UseOptionErr18 add span when context have a Telemetry
-func UseOptionErr18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+// UseOptionErr18 add span when context contains Telemetry
+func UseOptionErr18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1775,7 +2147,10 @@ func UseOptionErr18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1)
 		} else {
@@ -1786,15 +2161,19 @@ func UseOptionErr18[A1, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Cont
 }
 
 // Use19 add span when context not nil
-func Use19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
-
+func Use19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1807,16 +2186,16 @@ func Use19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context,
 }
 
 // UseErr19 add span when context not nil
-func UseErr19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1825,7 +2204,10 @@ func UseErr19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1)
 		} else {
@@ -1835,15 +2217,20 @@ func UseErr19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Contex
 	}
 }
 
-// UseOption19 add span when context have a Telemetry
-func UseOption19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+// UseOption19 add span when context contains Telemetry
+func UseOption19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1855,17 +2242,17 @@ func UseOption19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Con
 	}
 }
 
-// UseOptionErr19 add span when context have a Telemetry
-func UseOptionErr19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1) (string, []attribute.KeyValue)) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+// UseOptionErr19 add span when context contains Telemetry
+func UseOptionErr19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1874,7 +2261,10 @@ func UseOptionErr19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1)
 		} else {
@@ -1885,14 +2275,16 @@ func UseOptionErr19[A1, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.
 }
 
 // Use20 add span when context not nil
-func Use20[A1, A2 any](fn func(context.Context, A1, A2), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) {
+func Use20[A1, A2 any](fn func(context.Context, A1, A2), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1904,16 +2296,16 @@ func Use20[A1, A2 any](fn func(context.Context, A1, A2), pp TelemetryProviderFn,
 }
 
 // UseErr20 add span when context not nil
-func UseErr20[A1, A2 any](fn func(context.Context, A1, A2) error, pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) error {
+func UseErr20[A1, A2 any](fn func(context.Context, A1, A2) error, pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1922,7 +2314,10 @@ func UseErr20[A1, A2 any](fn func(context.Context, A1, A2) error, pp TelemetryPr
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2)
 		} else {
@@ -1933,14 +2328,16 @@ func UseErr20[A1, A2 any](fn func(context.Context, A1, A2) error, pp TelemetryPr
 }
 
 // UseOption20 add span when context contains Telemetry
-func UseOption20[A1, A2 any](fn func(context.Context, A1, A2), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) {
+func UseOption20[A1, A2 any](fn func(context.Context, A1, A2), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -1952,16 +2349,16 @@ func UseOption20[A1, A2 any](fn func(context.Context, A1, A2), sp func(A1, A2) (
 }
 
 // UseOptionErr20 add span when context contains Telemetry
-func UseOptionErr20[A1, A2 any](fn func(context.Context, A1, A2) error, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) error {
+func UseOptionErr20[A1, A2 any](fn func(context.Context, A1, A2) error, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -1970,7 +2367,10 @@ func UseOptionErr20[A1, A2 any](fn func(context.Context, A1, A2) error, sp func(
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2)
 		} else {
@@ -1981,14 +2381,19 @@ func UseOptionErr20[A1, A2 any](fn func(context.Context, A1, A2) error, sp func(
 }
 
 // Use21 add span when context not nil
-func Use21[A1, A2, R1 any](fn func(context.Context, A1, A2) R1, pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) R1 {
+func Use21[A1, A2, R1 any](fn func(context.Context, A1, A2) R1, pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2001,16 +2406,16 @@ func Use21[A1, A2, R1 any](fn func(context.Context, A1, A2) R1, pp TelemetryProv
 }
 
 // UseErr21 add span when context not nil
-func UseErr21[A1, A2, R1 any](fn func(context.Context, A1, A2) (R1, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, error) {
+func UseErr21[A1, A2, R1 any](fn func(context.Context, A1, A2) (R1, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2019,7 +2424,10 @@ func UseErr21[A1, A2, R1 any](fn func(context.Context, A1, A2) (R1, error), pp T
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2)
 		} else {
@@ -2029,15 +2437,20 @@ func UseErr21[A1, A2, R1 any](fn func(context.Context, A1, A2) (R1, error), pp T
 	}
 }
 
-// UseOption21 add span when context have a Telemetry
-func UseOption21[A1, A2, R1 any](fn func(context.Context, A1, A2) R1, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) R1 {
+// UseOption21 add span when context contains Telemetry
+func UseOption21[A1, A2, R1 any](fn func(context.Context, A1, A2) R1, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2049,17 +2462,17 @@ func UseOption21[A1, A2, R1 any](fn func(context.Context, A1, A2) R1, sp func(A1
 	}
 }
 
-// UseOptionErr21 add span when context have a Telemetry
-func UseOptionErr21[A1, A2, R1 any](fn func(context.Context, A1, A2) (R1, error), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, error) {
+// UseOptionErr21 add span when context contains Telemetry
+func UseOptionErr21[A1, A2, R1 any](fn func(context.Context, A1, A2) (R1, error), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2068,7 +2481,10 @@ func UseOptionErr21[A1, A2, R1 any](fn func(context.Context, A1, A2) (R1, error)
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2)
 		} else {
@@ -2079,14 +2495,19 @@ func UseOptionErr21[A1, A2, R1 any](fn func(context.Context, A1, A2) (R1, error)
 }
 
 // Use22 add span when context not nil
-func Use22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2) {
+func Use22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2099,16 +2520,16 @@ func Use22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2), pp Tel
 }
 
 // UseErr22 add span when context not nil
-func UseErr22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, error) {
+func UseErr22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2117,7 +2538,10 @@ func UseErr22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2, erro
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2)
 		} else {
@@ -2127,15 +2551,20 @@ func UseErr22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2, erro
 	}
 }
 
-// UseOption22 add span when context have a Telemetry
-func UseOption22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2) {
+// UseOption22 add span when context contains Telemetry
+func UseOption22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2147,17 +2576,17 @@ func UseOption22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2),
 	}
 }
 
-// UseOptionErr22 add span when context have a Telemetry
-func UseOptionErr22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2, error), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, error) {
+// UseOptionErr22 add span when context contains Telemetry
+func UseOptionErr22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2, error), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2166,7 +2595,10 @@ func UseOptionErr22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2)
 		} else {
@@ -2177,14 +2609,19 @@ func UseOptionErr22[A1, A2, R1, R2 any](fn func(context.Context, A1, A2) (R1, R2
 }
 
 // Use23 add span when context not nil
-func Use23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3) {
+func Use23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2197,16 +2634,16 @@ func Use23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2, R3)
 }
 
 // UseErr23 add span when context not nil
-func UseErr23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, error) {
+func UseErr23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2215,7 +2652,10 @@ func UseErr23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2)
 		} else {
@@ -2225,15 +2665,20 @@ func UseErr23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2,
 	}
 }
 
-// UseOption23 add span when context have a Telemetry
-func UseOption23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2, R3), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3) {
+// UseOption23 add span when context contains Telemetry
+func UseOption23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2, R3), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2245,17 +2690,17 @@ func UseOption23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R
 	}
 }
 
-// UseOptionErr23 add span when context have a Telemetry
-func UseOptionErr23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2, R3, error), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, error) {
+// UseOptionErr23 add span when context contains Telemetry
+func UseOptionErr23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1, R2, R3, error), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2264,7 +2709,10 @@ func UseOptionErr23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2)
 		} else {
@@ -2275,14 +2723,19 @@ func UseOptionErr23[A1, A2, R1, R2, R3 any](fn func(context.Context, A1, A2) (R1
 }
 
 // Use24 add span when context not nil
-func Use24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4) {
+func Use24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2295,16 +2748,16 @@ func Use24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1, R2,
 }
 
 // UseErr24 add span when context not nil
-func UseErr24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, error) {
+func UseErr24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2313,7 +2766,10 @@ func UseErr24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2)
 		} else {
@@ -2323,15 +2779,20 @@ func UseErr24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1,
 	}
 }
 
-// UseOption24 add span when context have a Telemetry
-func UseOption24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4) {
+// UseOption24 add span when context contains Telemetry
+func UseOption24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2343,17 +2804,17 @@ func UseOption24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R
 	}
 }
 
-// UseOptionErr24 add span when context have a Telemetry
-func UseOptionErr24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, error), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, error) {
+// UseOptionErr24 add span when context contains Telemetry
+func UseOptionErr24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, error), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2362,7 +2823,10 @@ func UseOptionErr24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2)
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2)
 		} else {
@@ -2373,14 +2837,19 @@ func UseOptionErr24[A1, A2, R1, R2, R3, R4 any](fn func(context.Context, A1, A2)
 }
 
 // Use25 add span when context not nil
-func Use25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5) {
+func Use25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2393,16 +2862,16 @@ func Use25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (R1,
 }
 
 // UseErr25 add span when context not nil
-func UseErr25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, error) {
+func UseErr25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2411,7 +2880,10 @@ func UseErr25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2)
 		} else {
@@ -2421,15 +2893,20 @@ func UseErr25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (
 	}
 }
 
-// UseOption25 add span when context have a Telemetry
-func UseOption25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5) {
+// UseOption25 add span when context contains Telemetry
+func UseOption25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2441,17 +2918,17 @@ func UseOption25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2
 	}
 }
 
-// UseOptionErr25 add span when context have a Telemetry
-func UseOptionErr25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, error), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, error) {
+// UseOptionErr25 add span when context contains Telemetry
+func UseOptionErr25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, error), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2460,7 +2937,10 @@ func UseOptionErr25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2)
 		} else {
@@ -2471,14 +2951,19 @@ func UseOptionErr25[A1, A2, R1, R2, R3, R4, R5 any](fn func(context.Context, A1,
 }
 
 // Use26 add span when context not nil
-func Use26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6) {
+func Use26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2491,17 +2976,16 @@ func Use26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2)
 }
 
 // UseErr26 add span when context not nil
-func UseErr26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, error) {
-
+func UseErr26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2510,7 +2994,10 @@ func UseErr26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2)
 		} else {
@@ -2520,15 +3007,20 @@ func UseErr26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A
 	}
 }
 
-// UseOption26 add span when context have a Telemetry
-func UseOption26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6) {
+// UseOption26 add span when context contains Telemetry
+func UseOption26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2540,17 +3032,17 @@ func UseOption26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1
 	}
 }
 
-// UseOptionErr26 add span when context have a Telemetry
-func UseOptionErr26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, error) {
+// UseOptionErr26 add span when context contains Telemetry
+func UseOptionErr26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2559,7 +3051,10 @@ func UseOptionErr26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2)
 		} else {
@@ -2570,14 +3065,19 @@ func UseOptionErr26[A1, A2, R1, R2, R3, R4, R5, R6 any](fn func(context.Context,
 }
 
 // Use27 add span when context not nil
-func Use27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7) {
+func Use27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2590,16 +3090,16 @@ func Use27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1,
 }
 
 // UseErr27 add span when context not nil
-func UseErr27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2608,7 +3108,10 @@ func UseErr27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2)
 		} else {
@@ -2618,15 +3121,20 @@ func UseErr27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A
 	}
 }
 
-// UseOption27 add span when context have a Telemetry
-func UseOption27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7) {
+// UseOption27 add span when context contains Telemetry
+func UseOption27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2638,17 +3146,17 @@ func UseOption27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context
 	}
 }
 
-// UseOptionErr27 add span when context have a Telemetry
-func UseOptionErr27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, error) {
+// UseOptionErr27 add span when context contains Telemetry
+func UseOptionErr27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2657,7 +3165,10 @@ func UseOptionErr27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2)
 		} else {
@@ -2668,14 +3179,19 @@ func UseOptionErr27[A1, A2, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Cont
 }
 
 // Use28 add span when context not nil
-func Use28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2688,16 +3204,16 @@ func Use28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context,
 }
 
 // UseErr28 add span when context not nil
-func UseErr28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2706,7 +3222,10 @@ func UseErr28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2)
 		} else {
@@ -2716,15 +3235,20 @@ func UseErr28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Contex
 	}
 }
 
-// UseOption28 add span when context have a Telemetry
-func UseOption28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8) {
+// UseOption28 add span when context contains Telemetry
+func UseOption28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2736,18 +3260,17 @@ func UseOption28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Con
 	}
 }
 
-// UseOptionErr28 add span when context have a Telemetry
-func UseOptionErr28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
-
+// UseOptionErr28 add span when context contains Telemetry
+func UseOptionErr28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2756,7 +3279,10 @@ func UseOptionErr28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2)
 		} else {
@@ -2767,14 +3293,19 @@ func UseOptionErr28[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.
 }
 
 // Use29 add span when context not nil
-func Use29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func Use29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2787,16 +3318,16 @@ func Use29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Conte
 }
 
 // UseErr29 add span when context not nil
-func UseErr29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2805,7 +3336,10 @@ func UseErr29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2)
 		} else {
@@ -2815,15 +3349,20 @@ func UseErr29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Co
 	}
 }
 
-// UseOption29 add span when context have a Telemetry
-func UseOption29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+// UseOption29 add span when context contains Telemetry
+func UseOption29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2835,17 +3374,17 @@ func UseOption29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context
 	}
 }
 
-// UseOptionErr29 add span when context have a Telemetry
-func UseOptionErr29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2) (string, []attribute.KeyValue)) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+// UseOptionErr29 add span when context contains Telemetry
+func UseOptionErr29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2854,7 +3393,10 @@ func UseOptionErr29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2)
 		} else {
@@ -2865,14 +3407,16 @@ func UseOptionErr29[A1, A2, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(cont
 }
 
 // Use30 add span when context not nil
-func Use30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) {
+func Use30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2884,16 +3428,16 @@ func Use30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3), pp TelemetryPro
 }
 
 // UseErr30 add span when context not nil
-func UseErr30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3) error, pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) error {
+func UseErr30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3) error, pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2902,7 +3446,10 @@ func UseErr30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3) error, pp Tel
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3)
 		} else {
@@ -2913,14 +3460,16 @@ func UseErr30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3) error, pp Tel
 }
 
 // UseOption30 add span when context contains Telemetry
-func UseOption30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) {
+func UseOption30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2932,16 +3481,16 @@ func UseOption30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3), sp func(A
 }
 
 // UseOptionErr30 add span when context contains Telemetry
-func UseOptionErr30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3) error, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) error {
+func UseOptionErr30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3) error, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2950,7 +3499,10 @@ func UseOptionErr30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3) error,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3)
 		} else {
@@ -2961,14 +3513,19 @@ func UseOptionErr30[A1, A2, A3 any](fn func(context.Context, A1, A2, A3) error,
 }
 
 // Use31 add span when context not nil
-func Use31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) R1, pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) R1 {
+func Use31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) R1, pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -2981,16 +3538,16 @@ func Use31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) R1, pp Telem
 }
 
 // UseErr31 add span when context not nil
-func UseErr31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, error) {
+func UseErr31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -2999,7 +3556,10 @@ func UseErr31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) (R1, erro
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3009,15 +3569,20 @@ func UseErr31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) (R1, erro
 	}
 }
 
-// UseOption31 add span when context have a Telemetry
-func UseOption31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) R1, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) R1 {
+// UseOption31 add span when context contains Telemetry
+func UseOption31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) R1, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3029,17 +3594,17 @@ func UseOption31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) R1, sp
 	}
 }
 
-// UseOptionErr31 add span when context have a Telemetry
-func UseOptionErr31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) (R1, error), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, error) {
+// UseOptionErr31 add span when context contains Telemetry
+func UseOptionErr31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) (R1, error), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3048,7 +3613,10 @@ func UseOptionErr31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) (R1
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3059,14 +3627,19 @@ func UseOptionErr31[A1, A2, A3, R1 any](fn func(context.Context, A1, A2, A3) (R1
 }
 
 // Use32 add span when context not nil
-func Use32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2) {
+func Use32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3079,16 +3652,16 @@ func Use32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1, R2)
 }
 
 // UseErr32 add span when context not nil
-func UseErr32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, error) {
+func UseErr32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3097,7 +3670,10 @@ func UseErr32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3107,15 +3683,20 @@ func UseErr32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1,
 	}
 }
 
-// UseOption32 add span when context have a Telemetry
-func UseOption32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1, R2), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2) {
+// UseOption32 add span when context contains Telemetry
+func UseOption32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1, R2), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3127,17 +3708,17 @@ func UseOption32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R
 	}
 }
 
-// UseOptionErr32 add span when context have a Telemetry
-func UseOptionErr32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1, R2, error), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, error) {
+// UseOptionErr32 add span when context contains Telemetry
+func UseOptionErr32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3) (R1, R2, error), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3146,7 +3727,10 @@ func UseOptionErr32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3)
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3157,14 +3741,19 @@ func UseOptionErr32[A1, A2, A3, R1, R2 any](fn func(context.Context, A1, A2, A3)
 }
 
 // Use33 add span when context not nil
-func Use33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3) {
+func Use33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3177,16 +3766,16 @@ func Use33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (R1,
 }
 
 // UseErr33 add span when context not nil
-func UseErr33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, error) {
+func UseErr33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3195,7 +3784,10 @@ func UseErr33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3205,15 +3797,20 @@ func UseErr33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (
 	}
 }
 
-// UseOption33 add span when context have a Telemetry
-func UseOption33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3) {
+// UseOption33 add span when context contains Telemetry
+func UseOption33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3225,17 +3822,17 @@ func UseOption33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3
 	}
 }
 
-// UseOptionErr33 add span when context have a Telemetry
-func UseOptionErr33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, error), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, error) {
+// UseOptionErr33 add span when context contains Telemetry
+func UseOptionErr33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, error), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3244,7 +3841,10 @@ func UseOptionErr33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3255,14 +3855,19 @@ func UseOptionErr33[A1, A2, A3, R1, R2, R3 any](fn func(context.Context, A1, A2,
 }
 
 // Use34 add span when context not nil
-func Use34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4) {
+func Use34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3275,16 +3880,16 @@ func Use34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3)
 }
 
 // UseErr34 add span when context not nil
-func UseErr34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, error) {
+func UseErr34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3293,7 +3898,10 @@ func UseErr34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3303,15 +3911,20 @@ func UseErr34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A
 	}
 }
 
-// UseOption34 add span when context have a Telemetry
-func UseOption34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4) {
+// UseOption34 add span when context contains Telemetry
+func UseOption34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3323,17 +3936,17 @@ func UseOption34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2
 	}
 }
 
-// UseOptionErr34 add span when context have a Telemetry
-func UseOptionErr34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, error), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, error) {
+// UseOptionErr34 add span when context contains Telemetry
+func UseOptionErr34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, error), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3342,7 +3955,10 @@ func UseOptionErr34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3353,14 +3969,19 @@ func UseOptionErr34[A1, A2, A3, R1, R2, R3, R4 any](fn func(context.Context, A1,
 }
 
 // Use35 add span when context not nil
-func Use35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5) {
+func Use35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3373,16 +3994,16 @@ func Use35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2,
 }
 
 // UseErr35 add span when context not nil
-func UseErr35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, error) {
+func UseErr35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3391,7 +4012,10 @@ func UseErr35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3401,15 +4025,20 @@ func UseErr35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A
 	}
 }
 
-// UseOption35 add span when context have a Telemetry
-func UseOption35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5) {
+// UseOption35 add span when context contains Telemetry
+func UseOption35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3421,17 +4050,17 @@ func UseOption35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1
 	}
 }
 
-// UseOptionErr35 add span when context have a Telemetry
-func UseOptionErr35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, error) {
+// UseOptionErr35 add span when context contains Telemetry
+func UseOptionErr35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3440,7 +4069,10 @@ func UseOptionErr35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3451,14 +4083,19 @@ func UseOptionErr35[A1, A2, A3, R1, R2, R3, R4, R5 any](fn func(context.Context,
 }
 
 // Use36 add span when context not nil
-func Use36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6) {
+func Use36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3471,16 +4108,16 @@ func Use36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1,
 }
 
 // UseErr36 add span when context not nil
-func UseErr36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, error) {
+func UseErr36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3489,7 +4126,10 @@ func UseErr36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3499,15 +4139,20 @@ func UseErr36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A
 	}
 }
 
-// UseOption36 add span when context have a Telemetry
-func UseOption36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6) {
+// UseOption36 add span when context contains Telemetry
+func UseOption36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3519,17 +4164,17 @@ func UseOption36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context
 	}
 }
 
-// UseOptionErr36 add span when context have a Telemetry
-func UseOptionErr36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, error) {
+// UseOptionErr36 add span when context contains Telemetry
+func UseOptionErr36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3538,7 +4183,10 @@ func UseOptionErr36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3549,14 +4197,19 @@ func UseOptionErr36[A1, A2, A3, R1, R2, R3, R4, R5, R6 any](fn func(context.Cont
 }
 
 // Use37 add span when context not nil
-func Use37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7) {
+func Use37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3569,16 +4222,16 @@ func Use37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context,
 }
 
 // UseErr37 add span when context not nil
-func UseErr37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3587,7 +4240,10 @@ func UseErr37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3597,15 +4253,20 @@ func UseErr37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Contex
 	}
 }
 
-// UseOption37 add span when context have a Telemetry
-func UseOption37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7) {
+// UseOption37 add span when context contains Telemetry
+func UseOption37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3617,17 +4278,17 @@ func UseOption37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Con
 	}
 }
 
-// UseOptionErr37 add span when context have a Telemetry
-func UseOptionErr37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, error) {
+// UseOptionErr37 add span when context contains Telemetry
+func UseOptionErr37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3636,7 +4297,10 @@ func UseOptionErr37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3647,14 +4311,19 @@ func UseOptionErr37[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.
 }
 
 // Use38 add span when context not nil
-func Use38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3667,16 +4336,16 @@ func Use38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Conte
 }
 
 // UseErr38 add span when context not nil
-func UseErr38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3685,7 +4354,10 @@ func UseErr38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3695,15 +4367,20 @@ func UseErr38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Co
 	}
 }
 
-// UseOption38 add span when context have a Telemetry
-func UseOption38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8) {
+// UseOption38 add span when context contains Telemetry
+func UseOption38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3715,17 +4392,17 @@ func UseOption38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context
 	}
 }
 
-// UseOptionErr38 add span when context have a Telemetry
-func UseOptionErr38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+// UseOptionErr38 add span when context contains Telemetry
+func UseOptionErr38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3734,7 +4411,10 @@ func UseOptionErr38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3745,14 +4425,19 @@ func UseOptionErr38[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(cont
 }
 
 // Use39 add span when context not nil
-func Use39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func Use39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3765,16 +4450,16 @@ func Use39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.C
 }
 
 // UseErr39 add span when context not nil
-func UseErr39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3783,7 +4468,10 @@ func UseErr39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3793,15 +4481,20 @@ func UseErr39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(contex
 	}
 }
 
-// UseOption39 add span when context have a Telemetry
-func UseOption39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+// UseOption39 add span when context contains Telemetry
+func UseOption39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3813,17 +4506,17 @@ func UseOption39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(con
 	}
 }
 
-// UseOptionErr39 add span when context have a Telemetry
-func UseOptionErr39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+// UseOptionErr39 add span when context contains Telemetry
+func UseOptionErr39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3832,7 +4525,10 @@ func UseOptionErr39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3)
 		} else {
@@ -3843,14 +4539,16 @@ func UseOptionErr39[A1, A2, A3, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(
 }
 
 // Use40 add span when context not nil
-func Use40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) {
+func Use40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3862,16 +4560,16 @@ func Use40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4), pp Tele
 }
 
 // UseErr40 add span when context not nil
-func UseErr40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) error {
+func UseErr40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3880,7 +4578,10 @@ func UseErr40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4) error
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -3891,14 +4592,16 @@ func UseErr40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4) error
 }
 
 // UseOption40 add span when context contains Telemetry
-func UseOption40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) {
+func UseOption40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3910,16 +4613,16 @@ func UseOption40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4), s
 }
 
 // UseOptionErr40 add span when context contains Telemetry
-func UseOptionErr40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4) error, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) error {
+func UseOptionErr40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4) error, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3928,7 +4631,10 @@ func UseOptionErr40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4)
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -3939,14 +4645,19 @@ func UseOptionErr40[A1, A2, A3, A4 any](fn func(context.Context, A1, A2, A3, A4)
 }
 
 // Use41 add span when context not nil
-func Use41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) R1 {
+func Use41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -3959,16 +4670,16 @@ func Use41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) R1,
 }
 
 // UseErr41 add span when context not nil
-func UseErr41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, error) {
+func UseErr41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -3977,7 +4688,10 @@ func UseErr41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) (
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -3987,15 +4701,20 @@ func UseErr41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) (
 	}
 }
 
-// UseOption41 add span when context have a Telemetry
-func UseOption41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) R1, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) R1 {
+// UseOption41 add span when context contains Telemetry
+func UseOption41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) R1, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4007,17 +4726,17 @@ func UseOption41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4
 	}
 }
 
-// UseOptionErr41 add span when context have a Telemetry
-func UseOptionErr41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) (R1, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, error) {
+// UseOptionErr41 add span when context contains Telemetry
+func UseOptionErr41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3, A4) (R1, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4026,7 +4745,10 @@ func UseOptionErr41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4037,14 +4759,19 @@ func UseOptionErr41[A1, A2, A3, A4, R1 any](fn func(context.Context, A1, A2, A3,
 }
 
 // Use42 add span when context not nil
-func Use42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2) {
+func Use42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4057,16 +4784,16 @@ func Use42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A4)
 }
 
 // UseErr42 add span when context not nil
-func UseErr42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, error) {
+func UseErr42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4075,7 +4802,10 @@ func UseErr42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4085,15 +4815,20 @@ func UseErr42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A
 	}
 }
 
-// UseOption42 add span when context have a Telemetry
-func UseOption42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2) {
+// UseOption42 add span when context contains Telemetry
+func UseOption42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4105,17 +4840,17 @@ func UseOption42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3
 	}
 }
 
-// UseOptionErr42 add span when context have a Telemetry
-func UseOptionErr42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, error) {
+// UseOptionErr42 add span when context contains Telemetry
+func UseOptionErr42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4124,7 +4859,10 @@ func UseOptionErr42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4135,14 +4873,19 @@ func UseOptionErr42[A1, A2, A3, A4, R1, R2 any](fn func(context.Context, A1, A2,
 }
 
 // Use43 add span when context not nil
-func Use43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3) {
+func Use43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4155,16 +4898,16 @@ func Use43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A3,
 }
 
 // UseErr43 add span when context not nil
-func UseErr43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, error) {
+func UseErr43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4173,7 +4916,10 @@ func UseErr43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4183,15 +4929,20 @@ func UseErr43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A
 	}
 }
 
-// UseOption43 add span when context have a Telemetry
-func UseOption43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3) {
+// UseOption43 add span when context contains Telemetry
+func UseOption43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4203,17 +4954,17 @@ func UseOption43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2
 	}
 }
 
-// UseOptionErr43 add span when context have a Telemetry
-func UseOptionErr43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, error) {
+// UseOptionErr43 add span when context contains Telemetry
+func UseOptionErr43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4222,7 +4973,10 @@ func UseOptionErr43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4233,14 +4987,19 @@ func UseOptionErr43[A1, A2, A3, A4, R1, R2, R3 any](fn func(context.Context, A1,
 }
 
 // Use44 add span when context not nil
-func Use44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4) {
+func Use44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4253,16 +5012,16 @@ func Use44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A2,
 }
 
 // UseErr44 add span when context not nil
-func UseErr44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, error) {
+func UseErr44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4271,7 +5030,10 @@ func UseErr44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4281,15 +5043,20 @@ func UseErr44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A
 	}
 }
 
-// UseOption44 add span when context have a Telemetry
-func UseOption44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4) {
+// UseOption44 add span when context contains Telemetry
+func UseOption44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4301,17 +5068,17 @@ func UseOption44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1
 	}
 }
 
-// UseOptionErr44 add span when context have a Telemetry
-func UseOptionErr44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, error) {
+// UseOptionErr44 add span when context contains Telemetry
+func UseOptionErr44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4320,7 +5087,10 @@ func UseOptionErr44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4331,15 +5101,19 @@ func UseOptionErr44[A1, A2, A3, A4, R1, R2, R3, R4 any](fn func(context.Context,
 }
 
 // Use45 add span when context not nil
-func Use45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5) {
-
+func Use45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4352,16 +5126,16 @@ func Use45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A1,
 }
 
 // UseErr45 add span when context not nil
-func UseErr45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, error) {
+func UseErr45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4370,7 +5144,10 @@ func UseErr45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4380,15 +5157,20 @@ func UseErr45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A
 	}
 }
 
-// UseOption45 add span when context have a Telemetry
-func UseOption45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5) {
+// UseOption45 add span when context contains Telemetry
+func UseOption45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4400,17 +5182,17 @@ func UseOption45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context
 	}
 }
 
-// UseOptionErr45 add span when context have a Telemetry
-func UseOptionErr45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, error) {
+// UseOptionErr45 add span when context contains Telemetry
+func UseOptionErr45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4419,7 +5201,10 @@ func UseOptionErr45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4430,14 +5215,19 @@ func UseOptionErr45[A1, A2, A3, A4, R1, R2, R3, R4, R5 any](fn func(context.Cont
 }
 
 // Use46 add span when context not nil
-func Use46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6) {
+func Use46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4450,16 +5240,16 @@ func Use46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Context,
 }
 
 // UseErr46 add span when context not nil
-func UseErr46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, error) {
+func UseErr46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4468,7 +5258,10 @@ func UseErr46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4478,15 +5271,20 @@ func UseErr46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Contex
 	}
 }
 
-// UseOption46 add span when context have a Telemetry
-func UseOption46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6) {
+// UseOption46 add span when context contains Telemetry
+func UseOption46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4498,17 +5296,17 @@ func UseOption46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Con
 	}
 }
 
-// UseOptionErr46 add span when context have a Telemetry
-func UseOptionErr46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, error) {
+// UseOptionErr46 add span when context contains Telemetry
+func UseOptionErr46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4517,7 +5315,10 @@ func UseOptionErr46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4528,14 +5329,19 @@ func UseOptionErr46[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6 any](fn func(context.
 }
 
 // Use47 add span when context not nil
-func Use47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7) {
+func Use47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4548,16 +5354,16 @@ func Use47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Conte
 }
 
 // UseErr47 add span when context not nil
-func UseErr47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4566,7 +5372,10 @@ func UseErr47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4576,15 +5385,20 @@ func UseErr47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Co
 	}
 }
 
-// UseOption47 add span when context have a Telemetry
-func UseOption47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7) {
+// UseOption47 add span when context contains Telemetry
+func UseOption47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4596,17 +5410,17 @@ func UseOption47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context
 	}
 }
 
-// UseOptionErr47 add span when context have a Telemetry
-func UseOptionErr47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, error) {
+// UseOptionErr47 add span when context contains Telemetry
+func UseOptionErr47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4615,7 +5429,10 @@ func UseOptionErr47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4626,14 +5443,19 @@ func UseOptionErr47[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7 any](fn func(cont
 }
 
 // Use48 add span when context not nil
-func Use48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4646,16 +5468,16 @@ func Use48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.C
 }
 
 // UseErr48 add span when context not nil
-func UseErr48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4664,7 +5486,10 @@ func UseErr48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4674,15 +5499,20 @@ func UseErr48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(contex
 	}
 }
 
-// UseOption48 add span when context have a Telemetry
-func UseOption48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8) {
+// UseOption48 add span when context contains Telemetry
+func UseOption48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4694,17 +5524,17 @@ func UseOption48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(con
 	}
 }
 
-// UseOptionErr48 add span when context have a Telemetry
-func UseOptionErr48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+// UseOptionErr48 add span when context contains Telemetry
+func UseOptionErr48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4713,7 +5543,10 @@ func UseOptionErr48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4724,14 +5557,19 @@ func UseOptionErr48[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(
 }
 
 // Use49 add span when context not nil
-func Use49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func Use49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4744,16 +5582,16 @@ func Use49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(conte
 }
 
 // UseErr49 add span when context not nil
-func UseErr49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4762,7 +5600,10 @@ func UseErr49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4772,15 +5613,20 @@ func UseErr49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(co
 	}
 }
 
-// UseOption49 add span when context have a Telemetry
-func UseOption49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+// UseOption49 add span when context contains Telemetry
+func UseOption49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4792,17 +5638,17 @@ func UseOption49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func
 	}
 }
 
-// UseOptionErr49 add span when context have a Telemetry
-func UseOptionErr49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+// UseOptionErr49 add span when context contains Telemetry
+func UseOptionErr49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4811,7 +5657,10 @@ func UseOptionErr49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn f
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4)
 		} else {
@@ -4822,14 +5671,16 @@ func UseOptionErr49[A1, A2, A3, A4, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn f
 }
 
 // Use50 add span when context not nil
-func Use50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) {
+func Use50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4841,16 +5692,16 @@ func Use50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A5),
 }
 
 // UseErr50 add span when context not nil
-func UseErr50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A5) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) error {
+func UseErr50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A5) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4859,7 +5710,10 @@ func UseErr50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -4870,14 +5724,16 @@ func UseErr50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A
 }
 
 // UseOption50 add span when context contains Telemetry
-func UseOption50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A5), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) {
+func UseOption50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A5), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4889,16 +5745,16 @@ func UseOption50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4
 }
 
 // UseOptionErr50 add span when context contains Telemetry
-func UseOptionErr50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A5) error, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) error {
+func UseOptionErr50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3, A4, A5) error, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4907,7 +5763,10 @@ func UseOptionErr50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -4918,14 +5777,19 @@ func UseOptionErr50[A1, A2, A3, A4, A5 any](fn func(context.Context, A1, A2, A3,
 }
 
 // Use51 add span when context not nil
-func Use51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A4, A5) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) R1 {
+func Use51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A4, A5) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4938,16 +5802,16 @@ func Use51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A4,
 }
 
 // UseErr51 add span when context not nil
-func UseErr51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, error) {
+func UseErr51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -4956,7 +5820,10 @@ func UseErr51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -4966,15 +5833,20 @@ func UseErr51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A
 	}
 }
 
-// UseOption51 add span when context have a Telemetry
-func UseOption51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A4, A5) R1, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) R1 {
+// UseOption51 add span when context contains Telemetry
+func UseOption51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A4, A5) R1, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -4986,17 +5858,17 @@ func UseOption51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3
 	}
 }
 
-// UseOptionErr51 add span when context have a Telemetry
-func UseOptionErr51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, error) {
+// UseOptionErr51 add span when context contains Telemetry
+func UseOptionErr51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5005,7 +5877,10 @@ func UseOptionErr51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5016,14 +5891,19 @@ func UseOptionErr51[A1, A2, A3, A4, A5, R1 any](fn func(context.Context, A1, A2,
 }
 
 // Use52 add span when context not nil
-func Use52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2) {
+func Use52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5036,17 +5916,16 @@ func Use52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A3,
 }
 
 // UseErr52 add span when context not nil
-func UseErr52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, error) {
-
+func UseErr52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5055,7 +5934,10 @@ func UseErr52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5065,15 +5947,20 @@ func UseErr52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A
 	}
 }
 
-// UseOption52 add span when context have a Telemetry
-func UseOption52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2) {
+// UseOption52 add span when context contains Telemetry
+func UseOption52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5085,17 +5972,17 @@ func UseOption52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2
 	}
 }
 
-// UseOptionErr52 add span when context have a Telemetry
-func UseOptionErr52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, error) {
+// UseOptionErr52 add span when context contains Telemetry
+func UseOptionErr52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5104,7 +5991,10 @@ func UseOptionErr52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5115,14 +6005,19 @@ func UseOptionErr52[A1, A2, A3, A4, A5, R1, R2 any](fn func(context.Context, A1,
 }
 
 // Use53 add span when context not nil
-func Use53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3) {
+func Use53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5135,16 +6030,16 @@ func Use53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A2,
 }
 
 // UseErr53 add span when context not nil
-func UseErr53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, error) {
+func UseErr53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5153,7 +6048,10 @@ func UseErr53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5163,15 +6061,20 @@ func UseErr53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A
 	}
 }
 
-// UseOption53 add span when context have a Telemetry
-func UseOption53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3) {
+// UseOption53 add span when context contains Telemetry
+func UseOption53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5183,17 +6086,17 @@ func UseOption53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1
 	}
 }
 
-// UseOptionErr53 add span when context have a Telemetry
-func UseOptionErr53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, error) {
+// UseOptionErr53 add span when context contains Telemetry
+func UseOptionErr53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5202,7 +6105,10 @@ func UseOptionErr53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5213,14 +6119,19 @@ func UseOptionErr53[A1, A2, A3, A4, A5, R1, R2, R3 any](fn func(context.Context,
 }
 
 // Use54 add span when context not nil
-func Use54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4) {
+func Use54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5233,16 +6144,16 @@ func Use54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A1,
 }
 
 // UseErr54 add span when context not nil
-func UseErr54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, error) {
+func UseErr54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5251,7 +6162,10 @@ func UseErr54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5261,15 +6175,20 @@ func UseErr54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A
 	}
 }
 
-// UseOption54 add span when context have a Telemetry
-func UseOption54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4) {
+// UseOption54 add span when context contains Telemetry
+func UseOption54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5281,18 +6200,17 @@ func UseOption54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context
 	}
 }
 
-// UseOptionErr54 add span when context have a Telemetry
-func UseOptionErr54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, error) {
-
+// UseOptionErr54 add span when context contains Telemetry
+func UseOptionErr54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5301,7 +6219,10 @@ func UseOptionErr54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5312,14 +6233,19 @@ func UseOptionErr54[A1, A2, A3, A4, A5, R1, R2, R3, R4 any](fn func(context.Cont
 }
 
 // Use55 add span when context not nil
-func Use55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5) {
+func Use55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5332,16 +6258,16 @@ func Use55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Context,
 }
 
 // UseErr55 add span when context not nil
-func UseErr55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, error) {
+func UseErr55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5350,7 +6276,10 @@ func UseErr55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5360,15 +6289,20 @@ func UseErr55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Contex
 	}
 }
 
-// UseOption55 add span when context have a Telemetry
-func UseOption55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5) {
+// UseOption55 add span when context contains Telemetry
+func UseOption55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5380,17 +6314,17 @@ func UseOption55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Con
 	}
 }
 
-// UseOptionErr55 add span when context have a Telemetry
-func UseOptionErr55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, error) {
+// UseOptionErr55 add span when context contains Telemetry
+func UseOptionErr55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5399,7 +6333,10 @@ func UseOptionErr55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5410,14 +6347,19 @@ func UseOptionErr55[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5 any](fn func(context.
 }
 
 // Use56 add span when context not nil
-func Use56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6) {
+func Use56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5430,16 +6372,16 @@ func Use56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Conte
 }
 
 // UseErr56 add span when context not nil
-func UseErr56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, error) {
+func UseErr56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5448,7 +6390,10 @@ func UseErr56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5458,15 +6403,20 @@ func UseErr56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Co
 	}
 }
 
-// UseOption56 add span when context have a Telemetry
-func UseOption56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6) {
+// UseOption56 add span when context contains Telemetry
+func UseOption56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5478,17 +6428,17 @@ func UseOption56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context
 	}
 }
 
-// UseOptionErr56 add span when context have a Telemetry
-func UseOptionErr56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, error) {
+// UseOptionErr56 add span when context contains Telemetry
+func UseOptionErr56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5497,7 +6447,10 @@ func UseOptionErr56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5508,14 +6461,19 @@ func UseOptionErr56[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6 any](fn func(cont
 }
 
 // Use57 add span when context not nil
-func Use57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7) {
+func Use57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5528,16 +6486,16 @@ func Use57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.C
 }
 
 // UseErr57 add span when context not nil
-func UseErr57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5546,7 +6504,10 @@ func UseErr57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5556,15 +6517,20 @@ func UseErr57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(contex
 	}
 }
 
-// UseOption57 add span when context have a Telemetry
-func UseOption57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7) {
+// UseOption57 add span when context contains Telemetry
+func UseOption57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5576,17 +6542,17 @@ func UseOption57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(con
 	}
 }
 
-// UseOptionErr57 add span when context have a Telemetry
-func UseOptionErr57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, error) {
+// UseOptionErr57 add span when context contains Telemetry
+func UseOptionErr57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5595,7 +6561,10 @@ func UseOptionErr57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5606,14 +6575,19 @@ func UseOptionErr57[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7 any](fn func(
 }
 
 // Use58 add span when context not nil
-func Use58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5626,16 +6600,16 @@ func Use58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(conte
 }
 
 // UseErr58 add span when context not nil
-func UseErr58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5644,7 +6618,10 @@ func UseErr58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5654,15 +6631,20 @@ func UseErr58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(co
 	}
 }
 
-// UseOption58 add span when context have a Telemetry
-func UseOption58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8) {
+// UseOption58 add span when context contains Telemetry
+func UseOption58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5674,17 +6656,17 @@ func UseOption58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func
 	}
 }
 
-// UseOptionErr58 add span when context have a Telemetry
-func UseOptionErr58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+// UseOptionErr58 add span when context contains Telemetry
+func UseOptionErr58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5693,7 +6675,10 @@ func UseOptionErr58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn f
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5704,14 +6689,19 @@ func UseOptionErr58[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8 any](fn f
 }
 
 // Use59 add span when context not nil
-func Use59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func Use59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5724,16 +6714,16 @@ func Use59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(c
 }
 
 // UseErr59 add span when context not nil
-func UseErr59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5742,7 +6732,10 @@ func UseErr59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn fun
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5752,15 +6745,20 @@ func UseErr59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn fun
 	}
 }
 
-// UseOption59 add span when context have a Telemetry
-func UseOption59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+// UseOption59 add span when context contains Telemetry
+func UseOption59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5772,17 +6770,17 @@ func UseOption59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn
 	}
 }
 
-// UseOptionErr59 add span when context have a Telemetry
-func UseOptionErr59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+// UseOptionErr59 add span when context contains Telemetry
+func UseOptionErr59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5791,7 +6789,10 @@ func UseOptionErr59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5)
 		} else {
@@ -5802,14 +6803,16 @@ func UseOptionErr59[A1, A2, A3, A4, A5, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](
 }
 
 // Use60 add span when context not nil
-func Use60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) {
+func Use60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5821,16 +6824,16 @@ func Use60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A4,
 }
 
 // UseErr60 add span when context not nil
-func UseErr60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) error {
+func UseErr60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5839,7 +6842,10 @@ func UseErr60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -5850,14 +6856,16 @@ func UseErr60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A
 }
 
 // UseOption60 add span when context contains Telemetry
-func UseOption60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) {
+func UseOption60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5869,16 +6877,16 @@ func UseOption60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3
 }
 
 // UseOptionErr60 add span when context contains Telemetry
-func UseOptionErr60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) error, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) error {
+func UseOptionErr60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) error, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5887,7 +6895,10 @@ func UseOptionErr60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -5898,14 +6909,19 @@ func UseOptionErr60[A1, A2, A3, A4, A5, A6 any](fn func(context.Context, A1, A2,
 }
 
 // Use61 add span when context not nil
-func Use61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) R1 {
+func Use61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5918,16 +6934,16 @@ func Use61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A3,
 }
 
 // UseErr61 add span when context not nil
-func UseErr61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, error) {
+func UseErr61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5936,7 +6952,10 @@ func UseErr61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -5946,15 +6965,20 @@ func UseErr61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A
 	}
 }
 
-// UseOption61 add span when context have a Telemetry
-func UseOption61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) R1, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) R1 {
+// UseOption61 add span when context contains Telemetry
+func UseOption61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) R1, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -5966,17 +6990,17 @@ func UseOption61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2
 	}
 }
 
-// UseOptionErr61 add span when context have a Telemetry
-func UseOptionErr61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, error) {
+// UseOptionErr61 add span when context contains Telemetry
+func UseOptionErr61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -5985,7 +7009,10 @@ func UseOptionErr61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -5996,14 +7023,19 @@ func UseOptionErr61[A1, A2, A3, A4, A5, A6, R1 any](fn func(context.Context, A1,
 }
 
 // Use62 add span when context not nil
-func Use62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2) {
+func Use62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6016,16 +7048,16 @@ func Use62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A2,
 }
 
 // UseErr62 add span when context not nil
-func UseErr62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, error) {
+func UseErr62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6034,7 +7066,10 @@ func UseErr62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6044,15 +7079,20 @@ func UseErr62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A
 	}
 }
 
-// UseOption62 add span when context have a Telemetry
-func UseOption62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2) {
+// UseOption62 add span when context contains Telemetry
+func UseOption62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6064,17 +7104,17 @@ func UseOption62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1
 	}
 }
 
-// UseOptionErr62 add span when context have a Telemetry
-func UseOptionErr62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, error) {
+// UseOptionErr62 add span when context contains Telemetry
+func UseOptionErr62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6083,7 +7123,10 @@ func UseOptionErr62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6094,14 +7137,19 @@ func UseOptionErr62[A1, A2, A3, A4, A5, A6, R1, R2 any](fn func(context.Context,
 }
 
 // Use63 add span when context not nil
-func Use63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3) {
+func Use63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6114,16 +7162,16 @@ func Use63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A1,
 }
 
 // UseErr63 add span when context not nil
-func UseErr63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, error) {
+func UseErr63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6132,7 +7180,10 @@ func UseErr63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6142,15 +7193,20 @@ func UseErr63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A
 	}
 }
 
-// UseOption63 add span when context have a Telemetry
-func UseOption63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3) {
+// UseOption63 add span when context contains Telemetry
+func UseOption63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6162,17 +7218,17 @@ func UseOption63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context
 	}
 }
 
-// UseOptionErr63 add span when context have a Telemetry
-func UseOptionErr63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, error) {
+// UseOptionErr63 add span when context contains Telemetry
+func UseOptionErr63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6181,7 +7237,10 @@ func UseOptionErr63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6192,14 +7251,19 @@ func UseOptionErr63[A1, A2, A3, A4, A5, A6, R1, R2, R3 any](fn func(context.Cont
 }
 
 // Use64 add span when context not nil
-func Use64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4) {
+func Use64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6212,16 +7276,16 @@ func Use64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Context,
 }
 
 // UseErr64 add span when context not nil
-func UseErr64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, error) {
+func UseErr64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6230,7 +7294,10 @@ func UseErr64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6240,15 +7307,20 @@ func UseErr64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Contex
 	}
 }
 
-// UseOption64 add span when context have a Telemetry
-func UseOption64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4) {
+// UseOption64 add span when context contains Telemetry
+func UseOption64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6260,17 +7332,17 @@ func UseOption64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Con
 	}
 }
 
-// UseOptionErr64 add span when context have a Telemetry
-func UseOptionErr64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, error) {
+// UseOptionErr64 add span when context contains Telemetry
+func UseOptionErr64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6279,7 +7351,10 @@ func UseOptionErr64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6290,14 +7365,19 @@ func UseOptionErr64[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4 any](fn func(context.
 }
 
 // Use65 add span when context not nil
-func Use65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5) {
+func Use65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6310,16 +7390,16 @@ func Use65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Conte
 }
 
 // UseErr65 add span when context not nil
-func UseErr65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, error) {
+func UseErr65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6328,7 +7408,10 @@ func UseErr65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6338,15 +7421,20 @@ func UseErr65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Co
 	}
 }
 
-// UseOption65 add span when context have a Telemetry
-func UseOption65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5) {
+// UseOption65 add span when context contains Telemetry
+func UseOption65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6358,17 +7446,17 @@ func UseOption65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context
 	}
 }
 
-// UseOptionErr65 add span when context have a Telemetry
-func UseOptionErr65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, error) {
+// UseOptionErr65 add span when context contains Telemetry
+func UseOptionErr65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6377,7 +7465,10 @@ func UseOptionErr65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6388,14 +7479,19 @@ func UseOptionErr65[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5 any](fn func(cont
 }
 
 // Use66 add span when context not nil
-func Use66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6) {
+func Use66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6408,16 +7504,16 @@ func Use66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(context.C
 }
 
 // UseErr66 add span when context not nil
-func UseErr66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, error) {
+func UseErr66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6426,7 +7522,10 @@ func UseErr66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6436,15 +7535,20 @@ func UseErr66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(contex
 	}
 }
 
-// UseOption66 add span when context have a Telemetry
-func UseOption66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6) {
+// UseOption66 add span when context contains Telemetry
+func UseOption66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6456,17 +7560,17 @@ func UseOption66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(con
 	}
 }
 
-// UseOptionErr66 add span when context have a Telemetry
-func UseOptionErr66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, error) {
+// UseOptionErr66 add span when context contains Telemetry
+func UseOptionErr66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6475,7 +7579,10 @@ func UseOptionErr66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6486,14 +7593,19 @@ func UseOptionErr66[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6 any](fn func(
 }
 
 // Use67 add span when context not nil
-func Use67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7) {
+func Use67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6506,16 +7618,16 @@ func Use67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(conte
 }
 
 // UseErr67 add span when context not nil
-func UseErr67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6524,7 +7636,10 @@ func UseErr67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6534,15 +7649,20 @@ func UseErr67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(co
 	}
 }
 
-// UseOption67 add span when context have a Telemetry
-func UseOption67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7) {
+// UseOption67 add span when context contains Telemetry
+func UseOption67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6554,17 +7674,17 @@ func UseOption67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func
 	}
 }
 
-// UseOptionErr67 add span when context have a Telemetry
-func UseOptionErr67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, error) {
+// UseOptionErr67 add span when context contains Telemetry
+func UseOptionErr67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6573,7 +7693,10 @@ func UseOptionErr67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn f
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6584,14 +7707,19 @@ func UseOptionErr67[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7 any](fn f
 }
 
 // Use68 add span when context not nil
-func Use68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6604,16 +7732,16 @@ func Use68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(c
 }
 
 // UseErr68 add span when context not nil
-func UseErr68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6622,7 +7750,10 @@ func UseErr68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn fun
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6632,15 +7763,20 @@ func UseErr68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn fun
 	}
 }
 
-// UseOption68 add span when context have a Telemetry
-func UseOption68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8) {
+// UseOption68 add span when context contains Telemetry
+func UseOption68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6652,17 +7788,17 @@ func UseOption68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn
 	}
 }
 
-// UseOptionErr68 add span when context have a Telemetry
-func UseOptionErr68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+// UseOptionErr68 add span when context contains Telemetry
+func UseOptionErr68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6671,7 +7807,10 @@ func UseOptionErr68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6682,14 +7821,19 @@ func UseOptionErr68[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8 any](
 }
 
 // Use69 add span when context not nil
-func Use69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func Use69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6702,16 +7846,16 @@ func Use69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn fu
 }
 
 // UseErr69 add span when context not nil
-func UseErr69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6720,7 +7864,10 @@ func UseErr69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6730,15 +7877,20 @@ func UseErr69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn
 	}
 }
 
-// UseOption69 add span when context have a Telemetry
-func UseOption69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+// UseOption69 add span when context contains Telemetry
+func UseOption69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6750,17 +7902,17 @@ func UseOption69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any]
 	}
 }
 
-// UseOptionErr69 add span when context have a Telemetry
-func UseOptionErr69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+// UseOptionErr69 add span when context contains Telemetry
+func UseOptionErr69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5, A6) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6769,7 +7921,10 @@ func UseOptionErr69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 a
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5, a6)
 		} else {
@@ -6780,14 +7935,16 @@ func UseOptionErr69[A1, A2, A3, A4, A5, A6, R1, R2, R3, R4, R5, R6, R7, R8, R9 a
 }
 
 // Use70 add span when context not nil
-func Use70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) {
+func Use70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6799,16 +7956,16 @@ func Use70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A3,
 }
 
 // UseErr70 add span when context not nil
-func UseErr70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) error {
+func UseErr70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6817,7 +7974,10 @@ func UseErr70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -6828,14 +7988,16 @@ func UseErr70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A
 }
 
 // UseOption70 add span when context contains Telemetry
-func UseOption70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) {
+func UseOption70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6847,16 +8009,16 @@ func UseOption70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2
 }
 
 // UseOptionErr70 add span when context contains Telemetry
-func UseOptionErr70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) error, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) error {
+func UseOptionErr70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) error, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6865,7 +8027,10 @@ func UseOptionErr70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -6876,15 +8041,19 @@ func UseOptionErr70[A1, A2, A3, A4, A5, A6, A7 any](fn func(context.Context, A1,
 }
 
 // Use71 add span when context not nil
-func Use71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) R1 {
-
+func Use71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6897,16 +8066,16 @@ func Use71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A2,
 }
 
 // UseErr71 add span when context not nil
-func UseErr71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, error) {
+func UseErr71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6915,7 +8084,10 @@ func UseErr71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -6925,15 +8097,20 @@ func UseErr71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A
 	}
 }
 
-// UseOption71 add span when context have a Telemetry
-func UseOption71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) R1, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) R1 {
+// UseOption71 add span when context contains Telemetry
+func UseOption71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) R1, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6945,17 +8122,17 @@ func UseOption71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1
 	}
 }
 
-// UseOptionErr71 add span when context have a Telemetry
-func UseOptionErr71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, error) {
+// UseOptionErr71 add span when context contains Telemetry
+func UseOptionErr71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -6964,7 +8141,10 @@ func UseOptionErr71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -6975,14 +8155,19 @@ func UseOptionErr71[A1, A2, A3, A4, A5, A6, A7, R1 any](fn func(context.Context,
 }
 
 // Use72 add span when context not nil
-func Use72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2) {
+func Use72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -6995,16 +8180,16 @@ func Use72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A1,
 }
 
 // UseErr72 add span when context not nil
-func UseErr72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, error) {
+func UseErr72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7013,7 +8198,10 @@ func UseErr72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7023,15 +8211,20 @@ func UseErr72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A
 	}
 }
 
-// UseOption72 add span when context have a Telemetry
-func UseOption72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2) {
+// UseOption72 add span when context contains Telemetry
+func UseOption72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7043,17 +8236,17 @@ func UseOption72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context
 	}
 }
 
-// UseOptionErr72 add span when context have a Telemetry
-func UseOptionErr72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, error) {
+// UseOptionErr72 add span when context contains Telemetry
+func UseOptionErr72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7062,7 +8255,10 @@ func UseOptionErr72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7073,14 +8269,19 @@ func UseOptionErr72[A1, A2, A3, A4, A5, A6, A7, R1, R2 any](fn func(context.Cont
 }
 
 // Use73 add span when context not nil
-func Use73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3) {
+func Use73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7093,16 +8294,16 @@ func Use73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Context,
 }
 
 // UseErr73 add span when context not nil
-func UseErr73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, error) {
+func UseErr73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7111,7 +8312,10 @@ func UseErr73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7121,16 +8325,20 @@ func UseErr73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Contex
 	}
 }
 
-// UseOption73 add span when context have a Telemetry
-func UseOption73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3) {
-
+// UseOption73 add span when context contains Telemetry
+func UseOption73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7142,17 +8350,17 @@ func UseOption73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Con
 	}
 }
 
-// UseOptionErr73 add span when context have a Telemetry
-func UseOptionErr73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, error) {
+// UseOptionErr73 add span when context contains Telemetry
+func UseOptionErr73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7161,7 +8369,10 @@ func UseOptionErr73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7172,14 +8383,19 @@ func UseOptionErr73[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3 any](fn func(context.
 }
 
 // Use74 add span when context not nil
-func Use74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4) {
+func Use74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7192,16 +8408,16 @@ func Use74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Conte
 }
 
 // UseErr74 add span when context not nil
-func UseErr74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, error) {
+func UseErr74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7210,7 +8426,10 @@ func UseErr74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7220,15 +8439,20 @@ func UseErr74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Co
 	}
 }
 
-// UseOption74 add span when context have a Telemetry
-func UseOption74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4) {
+// UseOption74 add span when context contains Telemetry
+func UseOption74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7240,17 +8464,17 @@ func UseOption74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context
 	}
 }
 
-// UseOptionErr74 add span when context have a Telemetry
-func UseOptionErr74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, error) {
+// UseOptionErr74 add span when context contains Telemetry
+func UseOptionErr74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7259,7 +8483,10 @@ func UseOptionErr74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7270,14 +8497,19 @@ func UseOptionErr74[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4 any](fn func(cont
 }
 
 // Use75 add span when context not nil
-func Use75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5) {
+func Use75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7290,16 +8522,16 @@ func Use75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(context.C
 }
 
 // UseErr75 add span when context not nil
-func UseErr75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, error) {
+func UseErr75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7308,7 +8540,10 @@ func UseErr75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7318,15 +8553,20 @@ func UseErr75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(contex
 	}
 }
 
-// UseOption75 add span when context have a Telemetry
-func UseOption75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5) {
+// UseOption75 add span when context contains Telemetry
+func UseOption75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7338,17 +8578,17 @@ func UseOption75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(con
 	}
 }
 
-// UseOptionErr75 add span when context have a Telemetry
-func UseOptionErr75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, error) {
+// UseOptionErr75 add span when context contains Telemetry
+func UseOptionErr75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7357,7 +8597,10 @@ func UseOptionErr75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7368,14 +8611,19 @@ func UseOptionErr75[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5 any](fn func(
 }
 
 // Use76 add span when context not nil
-func Use76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6) {
+func Use76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7388,16 +8636,16 @@ func Use76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(conte
 }
 
 // UseErr76 add span when context not nil
-func UseErr76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, error) {
+func UseErr76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7406,7 +8654,10 @@ func UseErr76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7416,15 +8667,20 @@ func UseErr76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(co
 	}
 }
 
-// UseOption76 add span when context have a Telemetry
-func UseOption76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6) {
+// UseOption76 add span when context contains Telemetry
+func UseOption76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7436,17 +8692,17 @@ func UseOption76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func
 	}
 }
 
-// UseOptionErr76 add span when context have a Telemetry
-func UseOptionErr76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, error) {
+// UseOptionErr76 add span when context contains Telemetry
+func UseOptionErr76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7455,7 +8711,10 @@ func UseOptionErr76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn f
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7466,14 +8725,19 @@ func UseOptionErr76[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6 any](fn f
 }
 
 // Use77 add span when context not nil
-func Use77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7) {
+func Use77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7486,16 +8750,16 @@ func Use77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn func(c
 }
 
 // UseErr77 add span when context not nil
-func UseErr77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7504,7 +8768,10 @@ func UseErr77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn fun
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7514,15 +8781,20 @@ func UseErr77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn fun
 	}
 }
 
-// UseOption77 add span when context have a Telemetry
-func UseOption77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7) {
+// UseOption77 add span when context contains Telemetry
+func UseOption77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7534,17 +8806,17 @@ func UseOption77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn
 	}
 }
 
-// UseOptionErr77 add span when context have a Telemetry
-func UseOptionErr77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, error) {
+// UseOptionErr77 add span when context contains Telemetry
+func UseOptionErr77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7553,7 +8825,10 @@ func UseOptionErr77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7564,14 +8839,19 @@ func UseOptionErr77[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7 any](
 }
 
 // Use78 add span when context not nil
-func Use78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7584,16 +8864,16 @@ func Use78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn fu
 }
 
 // UseErr78 add span when context not nil
-func UseErr78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7602,7 +8882,10 @@ func UseErr78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7612,15 +8895,20 @@ func UseErr78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn
 	}
 }
 
-// UseOption78 add span when context have a Telemetry
-func UseOption78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8) {
+// UseOption78 add span when context contains Telemetry
+func UseOption78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7632,17 +8920,17 @@ func UseOption78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any]
 	}
 }
 
-// UseOptionErr78 add span when context have a Telemetry
-func UseOptionErr78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+// UseOptionErr78 add span when context contains Telemetry
+func UseOptionErr78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7651,7 +8939,10 @@ func UseOptionErr78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 a
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7662,14 +8953,19 @@ func UseOptionErr78[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8 a
 }
 
 // Use79 add span when context not nil
-func Use79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func Use79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7682,16 +8978,16 @@ func Use79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](f
 }
 
 // UseErr79 add span when context not nil
-func UseErr79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7700,7 +8996,10 @@ func UseErr79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7710,15 +9009,20 @@ func UseErr79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any
 	}
 }
 
-// UseOption79 add span when context have a Telemetry
-func UseOption79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+// UseOption79 add span when context contains Telemetry
+func UseOption79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7730,17 +9034,17 @@ func UseOption79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9
 	}
 }
 
-// UseOptionErr79 add span when context have a Telemetry
-func UseOptionErr79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+// UseOptionErr79 add span when context contains Telemetry
+func UseOptionErr79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5, A6, A7) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7749,7 +9053,10 @@ func UseOptionErr79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5, a6, a7)
 		} else {
@@ -7760,14 +9067,16 @@ func UseOptionErr79[A1, A2, A3, A4, A5, A6, A7, R1, R2, R3, R4, R5, R6, R7, R8,
 }
 
 // Use80 add span when context not nil
-func Use80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) {
+func Use80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7779,16 +9088,16 @@ func Use80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A2,
 }
 
 // UseErr80 add span when context not nil
-func UseErr80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) error {
+func UseErr80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7797,7 +9106,10 @@ func UseErr80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -7808,14 +9120,16 @@ func UseErr80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A
 }
 
 // UseOption80 add span when context contains Telemetry
-func UseOption80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) {
+func UseOption80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7827,16 +9141,16 @@ func UseOption80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1
 }
 
 // UseOptionErr80 add span when context contains Telemetry
-func UseOptionErr80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) error, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) error {
+func UseOptionErr80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) error, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7845,7 +9159,10 @@ func UseOptionErr80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -7856,14 +9173,19 @@ func UseOptionErr80[A1, A2, A3, A4, A5, A6, A7, A8 any](fn func(context.Context,
 }
 
 // Use81 add span when context not nil
-func Use81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) R1 {
+func Use81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7876,16 +9198,16 @@ func Use81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A1,
 }
 
 // UseErr81 add span when context not nil
-func UseErr81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, error) {
+func UseErr81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7894,7 +9216,10 @@ func UseErr81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -7904,15 +9229,20 @@ func UseErr81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A
 	}
 }
 
-// UseOption81 add span when context have a Telemetry
-func UseOption81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) R1, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) R1 {
+// UseOption81 add span when context contains Telemetry
+func UseOption81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) R1, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7924,17 +9254,17 @@ func UseOption81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context
 	}
 }
 
-// UseOptionErr81 add span when context have a Telemetry
-func UseOptionErr81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, error) {
+// UseOptionErr81 add span when context contains Telemetry
+func UseOptionErr81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7943,7 +9273,10 @@ func UseOptionErr81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -7954,14 +9287,19 @@ func UseOptionErr81[A1, A2, A3, A4, A5, A6, A7, A8, R1 any](fn func(context.Cont
 }
 
 // Use82 add span when context not nil
-func Use82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2) {
+func Use82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -7974,16 +9312,16 @@ func Use82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Context,
 }
 
 // UseErr82 add span when context not nil
-func UseErr82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, error) {
+func UseErr82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -7992,7 +9330,10 @@ func UseErr82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8002,15 +9343,20 @@ func UseErr82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Contex
 	}
 }
 
-// UseOption82 add span when context have a Telemetry
-func UseOption82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2) {
+// UseOption82 add span when context contains Telemetry
+func UseOption82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8022,17 +9368,17 @@ func UseOption82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Con
 	}
 }
 
-// UseOptionErr82 add span when context have a Telemetry
-func UseOptionErr82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, error) {
+// UseOptionErr82 add span when context contains Telemetry
+func UseOptionErr82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8041,7 +9387,10 @@ func UseOptionErr82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8052,14 +9401,19 @@ func UseOptionErr82[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2 any](fn func(context.
 }
 
 // Use83 add span when context not nil
-func Use83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3) {
+func Use83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8072,16 +9426,16 @@ func Use83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Conte
 }
 
 // UseErr83 add span when context not nil
-func UseErr83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, error) {
+func UseErr83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8090,7 +9444,10 @@ func UseErr83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8100,15 +9457,20 @@ func UseErr83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Co
 	}
 }
 
-// UseOption83 add span when context have a Telemetry
-func UseOption83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3) {
+// UseOption83 add span when context contains Telemetry
+func UseOption83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8120,17 +9482,17 @@ func UseOption83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context
 	}
 }
 
-// UseOptionErr83 add span when context have a Telemetry
-func UseOptionErr83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, error) {
+// UseOptionErr83 add span when context contains Telemetry
+func UseOptionErr83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8139,7 +9501,10 @@ func UseOptionErr83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8150,14 +9515,19 @@ func UseOptionErr83[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3 any](fn func(cont
 }
 
 // Use84 add span when context not nil
-func Use84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4) {
+func Use84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8170,16 +9540,16 @@ func Use84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(context.C
 }
 
 // UseErr84 add span when context not nil
-func UseErr84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, error) {
+func UseErr84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8188,7 +9558,10 @@ func UseErr84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8198,15 +9571,20 @@ func UseErr84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(contex
 	}
 }
 
-// UseOption84 add span when context have a Telemetry
-func UseOption84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4) {
+// UseOption84 add span when context contains Telemetry
+func UseOption84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8218,17 +9596,17 @@ func UseOption84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(con
 	}
 }
 
-// UseOptionErr84 add span when context have a Telemetry
-func UseOptionErr84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, error) {
+// UseOptionErr84 add span when context contains Telemetry
+func UseOptionErr84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8237,7 +9615,10 @@ func UseOptionErr84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8248,14 +9629,19 @@ func UseOptionErr84[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4 any](fn func(
 }
 
 // Use85 add span when context not nil
-func Use85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5) {
+func Use85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8268,16 +9654,16 @@ func Use85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(conte
 }
 
 // UseErr85 add span when context not nil
-func UseErr85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, error) {
+func UseErr85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8286,7 +9672,10 @@ func UseErr85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8296,15 +9685,20 @@ func UseErr85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(co
 	}
 }
 
-// UseOption85 add span when context have a Telemetry
-func UseOption85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5) {
+// UseOption85 add span when context contains Telemetry
+func UseOption85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8316,17 +9710,17 @@ func UseOption85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func
 	}
 }
 
-// UseOptionErr85 add span when context have a Telemetry
-func UseOptionErr85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, error) {
+// UseOptionErr85 add span when context contains Telemetry
+func UseOptionErr85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8335,7 +9729,10 @@ func UseOptionErr85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn f
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8346,14 +9743,19 @@ func UseOptionErr85[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5 any](fn f
 }
 
 // Use86 add span when context not nil
-func Use86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6) {
+func Use86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8366,16 +9768,16 @@ func Use86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn func(c
 }
 
 // UseErr86 add span when context not nil
-func UseErr86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, error) {
+func UseErr86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8384,7 +9786,10 @@ func UseErr86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn fun
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8394,15 +9799,20 @@ func UseErr86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn fun
 	}
 }
 
-// UseOption86 add span when context have a Telemetry
-func UseOption86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6) {
+// UseOption86 add span when context contains Telemetry
+func UseOption86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8414,17 +9824,17 @@ func UseOption86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn
 	}
 }
 
-// UseOptionErr86 add span when context have a Telemetry
-func UseOptionErr86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, error) {
+// UseOptionErr86 add span when context contains Telemetry
+func UseOptionErr86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8433,7 +9843,10 @@ func UseOptionErr86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8444,14 +9857,19 @@ func UseOptionErr86[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6 any](
 }
 
 // Use87 add span when context not nil
-func Use87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7) {
+func Use87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8464,16 +9882,16 @@ func Use87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn fu
 }
 
 // UseErr87 add span when context not nil
-func UseErr87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8482,7 +9900,10 @@ func UseErr87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8492,15 +9913,20 @@ func UseErr87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn
 	}
 }
 
-// UseOption87 add span when context have a Telemetry
-func UseOption87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7) {
+// UseOption87 add span when context contains Telemetry
+func UseOption87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8512,17 +9938,17 @@ func UseOption87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any]
 	}
 }
 
-// UseOptionErr87 add span when context have a Telemetry
-func UseOptionErr87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, error) {
+// UseOptionErr87 add span when context contains Telemetry
+func UseOptionErr87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8531,7 +9957,10 @@ func UseOptionErr87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 a
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8542,14 +9971,19 @@ func UseOptionErr87[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7 a
 }
 
 // Use88 add span when context not nil
-func Use88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8562,16 +9996,16 @@ func Use88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any](f
 }
 
 // UseErr88 add span when context not nil
-func UseErr88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8580,7 +10014,10 @@ func UseErr88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8590,15 +10027,20 @@ func UseErr88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any
 	}
 }
 
-// UseOption88 add span when context have a Telemetry
-func UseOption88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8) {
+// UseOption88 add span when context contains Telemetry
+func UseOption88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8610,17 +10052,17 @@ func UseOption88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8
 	}
 }
 
-// UseOptionErr88 add span when context have a Telemetry
-func UseOptionErr88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+// UseOptionErr88 add span when context contains Telemetry
+func UseOptionErr88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8629,7 +10071,10 @@ func UseOptionErr88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8640,14 +10085,19 @@ func UseOptionErr88[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7,
 }
 
 // Use89 add span when context not nil
-func Use89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func Use89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8660,16 +10110,16 @@ func Use89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9 an
 }
 
 // UseErr89 add span when context not nil
-func UseErr89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8678,7 +10128,10 @@ func UseErr89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8688,15 +10141,20 @@ func UseErr89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9
 	}
 }
 
-// UseOption89 add span when context have a Telemetry
-func UseOption89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+// UseOption89 add span when context contains Telemetry
+func UseOption89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8708,17 +10166,17 @@ func UseOption89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8,
 	}
 }
 
-// UseOptionErr89 add span when context have a Telemetry
-func UseOptionErr89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+// UseOptionErr89 add span when context contains Telemetry
+func UseOptionErr89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8727,7 +10185,10 @@ func UseOptionErr89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8)
 		} else {
@@ -8738,14 +10199,16 @@ func UseOptionErr89[A1, A2, A3, A4, A5, A6, A7, A8, R1, R2, R3, R4, R5, R6, R7,
 }
 
 // Use90 add span when context not nil
-func Use90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) {
+func Use90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8757,16 +10220,16 @@ func Use90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A1,
 }
 
 // UseErr90 add span when context not nil
-func UseErr90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) error {
+func UseErr90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) error, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8775,7 +10238,10 @@ func UseErr90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -8786,14 +10252,16 @@ func UseErr90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A
 }
 
 // UseOption90 add span when context contains Telemetry
-func UseOption90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) {
+func UseOption90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8805,16 +10273,16 @@ func UseOption90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context
 }
 
 // UseOptionErr90 add span when context contains Telemetry
-func UseOptionErr90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) error, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) error {
+func UseOptionErr90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) error, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) error {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8823,7 +10291,10 @@ func UseOptionErr90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results(nil, err)
 				}
+				c.end(err, rec)
 			}()
 			err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -8834,14 +10305,19 @@ func UseOptionErr90[A1, A2, A3, A4, A5, A6, A7, A8, A9 any](fn func(context.Cont
 }
 
 // Use91 add span when context not nil
-func Use91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) R1 {
+func Use91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) R1, pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8854,16 +10330,16 @@ func Use91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Context,
 }
 
 // UseErr91 add span when context not nil
-func UseErr91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, error) {
+func UseErr91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8872,7 +10348,10 @@ func UseErr91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -8882,15 +10361,20 @@ func UseErr91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Contex
 	}
 }
 
-// UseOption91 add span when context have a Telemetry
-func UseOption91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) R1, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) R1 {
+// UseOption91 add span when context contains Telemetry
+func UseOption91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) R1, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) R1 {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8902,17 +10386,17 @@ func UseOption91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Con
 	}
 }
 
-// UseOptionErr91 add span when context have a Telemetry
-func UseOptionErr91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, error) {
+// UseOptionErr91 add span when context contains Telemetry
+func UseOptionErr91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8921,7 +10405,10 @@ func UseOptionErr91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -8932,14 +10419,19 @@ func UseOptionErr91[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1 any](fn func(context.
 }
 
 // Use92 add span when context not nil
-func Use92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2) {
+func Use92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -8952,16 +10444,16 @@ func Use92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Conte
 }
 
 // UseErr92 add span when context not nil
-func UseErr92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, error) {
+func UseErr92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -8970,7 +10462,10 @@ func UseErr92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -8980,15 +10475,20 @@ func UseErr92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Co
 	}
 }
 
-// UseOption92 add span when context have a Telemetry
-func UseOption92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2) {
+// UseOption92 add span when context contains Telemetry
+func UseOption92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9000,17 +10500,17 @@ func UseOption92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context
 	}
 }
 
-// UseOptionErr92 add span when context have a Telemetry
-func UseOptionErr92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, error) {
+// UseOptionErr92 add span when context contains Telemetry
+func UseOptionErr92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9019,7 +10519,10 @@ func UseOptionErr92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(cont
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9030,14 +10533,19 @@ func UseOptionErr92[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2 any](fn func(cont
 }
 
 // Use93 add span when context not nil
-func Use93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3) {
+func Use93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9050,16 +10558,16 @@ func Use93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(context.C
 }
 
 // UseErr93 add span when context not nil
-func UseErr93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, error) {
+func UseErr93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9068,7 +10576,10 @@ func UseErr93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(contex
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9078,15 +10589,20 @@ func UseErr93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(contex
 	}
 }
 
-// UseOption93 add span when context have a Telemetry
-func UseOption93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3) {
+// UseOption93 add span when context contains Telemetry
+func UseOption93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9098,17 +10614,17 @@ func UseOption93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(con
 	}
 }
 
-// UseOptionErr93 add span when context have a Telemetry
-func UseOptionErr93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, error) {
+// UseOptionErr93 add span when context contains Telemetry
+func UseOptionErr93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9117,7 +10633,10 @@ func UseOptionErr93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9128,14 +10647,19 @@ func UseOptionErr93[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3 any](fn func(
 }
 
 // Use94 add span when context not nil
-func Use94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4) {
+func Use94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9148,16 +10672,16 @@ func Use94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(conte
 }
 
 // UseErr94 add span when context not nil
-func UseErr94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, error) {
+func UseErr94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9166,7 +10690,10 @@ func UseErr94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(co
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9176,15 +10703,20 @@ func UseErr94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(co
 	}
 }
 
-// UseOption94 add span when context have a Telemetry
-func UseOption94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4) {
+// UseOption94 add span when context contains Telemetry
+func UseOption94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9196,17 +10728,17 @@ func UseOption94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func
 	}
 }
 
-// UseOptionErr94 add span when context have a Telemetry
-func UseOptionErr94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, error) {
+// UseOptionErr94 add span when context contains Telemetry
+func UseOptionErr94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9215,7 +10747,10 @@ func UseOptionErr94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn f
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9226,14 +10761,19 @@ func UseOptionErr94[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4 any](fn f
 }
 
 // Use95 add span when context not nil
-func Use95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5) {
+func Use95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9246,16 +10786,16 @@ func Use95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn func(c
 }
 
 // UseErr95 add span when context not nil
-func UseErr95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, error) {
+func UseErr95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9264,7 +10804,10 @@ func UseErr95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn fun
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9274,15 +10817,20 @@ func UseErr95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn fun
 	}
 }
 
-// UseOption95 add span when context have a Telemetry
-func UseOption95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5) {
+// UseOption95 add span when context contains Telemetry
+func UseOption95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9294,17 +10842,17 @@ func UseOption95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn
 	}
 }
 
-// UseOptionErr95 add span when context have a Telemetry
-func UseOptionErr95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, error) {
+// UseOptionErr95 add span when context contains Telemetry
+func UseOptionErr95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9313,7 +10861,10 @@ func UseOptionErr95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9324,14 +10875,19 @@ func UseOptionErr95[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5 any](
 }
 
 // Use96 add span when context not nil
-func Use96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6) {
+func Use96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9344,16 +10900,16 @@ func Use96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn fu
 }
 
 // UseErr96 add span when context not nil
-func UseErr96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, error) {
+func UseErr96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9362,7 +10918,10 @@ func UseErr96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9372,15 +10931,20 @@ func UseErr96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn
 	}
 }
 
-// UseOption96 add span when context have a Telemetry
-func UseOption96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6) {
+// UseOption96 add span when context contains Telemetry
+func UseOption96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9392,17 +10956,17 @@ func UseOption96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any]
 	}
 }
 
-// UseOptionErr96 add span when context have a Telemetry
-func UseOptionErr96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, error) {
+// UseOptionErr96 add span when context contains Telemetry
+func UseOptionErr96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9411,7 +10975,10 @@ func UseOptionErr96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 a
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9422,14 +10989,19 @@ func UseOptionErr96[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6 a
 }
 
 // Use97 add span when context not nil
-func Use97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7) {
+func Use97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9442,16 +11014,16 @@ func Use97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any](f
 }
 
 // UseErr97 add span when context not nil
-func UseErr97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, error) {
+func UseErr97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9460,7 +11032,10 @@ func UseErr97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9470,15 +11045,20 @@ func UseErr97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any
 	}
 }
 
-// UseOption97 add span when context have a Telemetry
-func UseOption97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7) {
+// UseOption97 add span when context contains Telemetry
+func UseOption97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9490,17 +11070,17 @@ func UseOption97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7
 	}
 }
 
-// UseOptionErr97 add span when context have a Telemetry
-func UseOptionErr97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, error) {
+// UseOptionErr97 add span when context contains Telemetry
+func UseOptionErr97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9509,7 +11089,10 @@ func UseOptionErr97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9520,14 +11103,19 @@ func UseOptionErr97[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6,
 }
 
 // Use98 add span when context not nil
-func Use98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8) {
+func Use98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9540,16 +11128,16 @@ func Use98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8 an
 }
 
 // UseErr98 add span when context not nil
-func UseErr98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+func UseErr98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9558,7 +11146,10 @@ func UseErr98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9568,20 +11159,24 @@ func UseErr98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8
 	}
 }
 
-// UseOption98 add span when context have a Telemetry
-func UseOption98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8) {
+// UseOption98 add span when context contains Telemetry
+func UseOption98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8 = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
-
 		} else {
 			r1, r2, r3, r4, r5, r6, r7, r8 = fn(ctx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		}
@@ -9589,17 +11184,17 @@ func UseOption98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7,
 	}
 }
 
-// UseOptionErr98 add span when context have a Telemetry
-func UseOptionErr98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+// UseOptionErr98 add span when context contains Telemetry
+func UseOptionErr98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9608,7 +11203,10 @@ func UseOptionErr98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9619,14 +11217,19 @@ func UseOptionErr98[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6,
 }
 
 // Use99 add span when context not nil
-func Use99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+func Use99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9639,16 +11242,16 @@ func Use99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8, R
 }
 
 // UseErr99 add span when context not nil
-func UseErr99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+func UseErr99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), pp TelemetryProviderFn, sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t, cx := ByContext(ctx, pp); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, cx, a...)
+			c, cx := w.start(t, cx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9657,7 +11260,10 @@ func UseErr99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -9667,15 +11273,20 @@ func UseErr99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8
 	}
 }
 
-// UseOption99 add span when context have a Telemetry
-func UseOption99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+// UseOption99 add span when context contains Telemetry
+func UseOption99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if r, ok := t.HandleRecover(recover()); ok {
+				r, ok := t.HandleRecover(recover())
+				if !ok && c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, nil)
+				}
+				c.end(nil, r)
+				if ok {
 					panic(r)
 				}
 			}()
@@ -9687,17 +11298,17 @@ func UseOption99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7,
 	}
 }
 
-// UseOptionErr99 add span when context have a Telemetry
-func UseOptionErr99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue)) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+// UseOptionErr99 add span when context contains Telemetry
+func UseOptionErr99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](fn func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error), sp func(A1, A2, A3, A4, A5, A6, A7, A8, A9) (string, []attribute.KeyValue), opts ...WrapOption) func(context.Context, A1, A2, A3, A4, A5, A6, A7, A8, A9) (R1, R2, R3, R4, R5, R6, R7, R8, R9, error) {
+	w := newWrapConfig(opts)
 	return func(ctx context.Context, a1 A1, a2 A2, a3 A3, a4 A4, a5 A5, a6 A6, a7 A7, a8 A8, a9 A9) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, r8 R8, r9 R9, err error) {
 		if t := FromContext(ctx); t != nil {
 			n, a := sp(a1, a2, a3, a4, a5, a6, a7, a8, a9)
-			cx, s := t.StartSpan(n, ctx, a...)
+			c, cx := w.start(t, ctx, n, a)
 			defer func() {
-				defer s.End()
-				if err != nil {
-					t.HandleError(err)
-				} else if r, ok := t.HandleRecover(recover()); ok {
+				var rec any
+				if r, ok := t.HandleRecover(recover()); ok {
+					rec = r
 					switch x := r.(type) {
 					case error:
 						err = x
@@ -9706,7 +11317,10 @@ func UseOptionErr99[A1, A2, A3, A4, A5, A6, A7, A8, A9, R1, R2, R3, R4, R5, R6,
 					default:
 						err = fmt.Errorf("%v", x)
 					}
+				} else if c.result != nil {
+					c.results([]any{r1, r2, r3, r4, r5, r6, r7, r8, r9}, err)
 				}
+				c.end(err, rec)
 			}()
 			r1, r2, r3, r4, r5, r6, r7, r8, r9, err = fn(cx, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 		} else {
@@ -0,0 +1,170 @@
+package ote
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//go:generate go run ./cmd/ote-gen -o use.go
+
+// WrapOption option of Use* wrappers generated by cmd/ote-gen
+type WrapOption func(*wrapConfig)
+
+type wrapConfig struct {
+	metrics  bool
+	kind     trace.SpanKind
+	attrs    []attribute.KeyValue
+	failure  func(err error) bool
+	result   func(results []any, err error) []attribute.KeyValue
+	spanName string
+	metric   string
+}
+
+// WithMetrics record function.calls, function.errors and function.duration of each call on the Telemetry meter,
+// with attributes span.name and outcome of ok, error or panic. Span names carrying ids or other unbounded values
+// explode the metric series, use WithMetricName to give such calls a low-cardinality name.
+func WithMetrics() WrapOption {
+	return func(c *wrapConfig) {
+		c.metrics = true
+	}
+}
+
+// WithSpanKind span kind of each call, default internal
+func WithSpanKind(kind trace.SpanKind) WrapOption {
+	return func(c *wrapConfig) {
+		c.kind = kind
+	}
+}
+
+// WithAttributes static attributes added to the span of each call
+func WithAttributes(attrs ...attribute.KeyValue) WrapOption {
+	return func(c *wrapConfig) {
+		c.attrs = append(c.attrs, attrs...)
+	}
+}
+
+// WithErrorClassifier fn reports whether a returned error is a failure, others neither mark the span as failed
+// nor are counted as errors, default all errors are failures.
+func WithErrorClassifier(fn func(err error) bool) WrapOption {
+	return func(c *wrapConfig) {
+		c.failure = fn
+	}
+}
+
+// WithExpectedErrors errors like sql.ErrNoRows or context.Canceled matched by errors.Is are not failures
+func WithExpectedErrors(errs ...error) WrapOption {
+	return WithErrorClassifier(func(err error) bool {
+		for _, e := range errs {
+			if errors.Is(err, e) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// WithResult fn extract span attributes from results and the returned error of a call which did not panic
+func WithResult(fn func(results []any, err error) []attribute.KeyValue) WrapOption {
+	return func(c *wrapConfig) {
+		c.result = fn
+	}
+}
+
+// WithSpanName override the span name given by the span provider
+func WithSpanName(name string) WrapOption {
+	return func(c *wrapConfig) {
+		c.spanName = name
+	}
+}
+
+// WithMetricName the span.name attribute of metrics recorded by WithMetrics, default the span name
+func WithMetricName(name string) WrapOption {
+	return func(c *wrapConfig) {
+		c.metric = name
+	}
+}
+
+func newWrapConfig(opts []WrapOption) *wrapConfig {
+	c := new(wrapConfig)
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// span name and attributes of sp, nil sp names span by the wrapper function
+func (sp SpanProviderFn) span() (string, []attribute.KeyValue) {
+	if sp != nil {
+		return sp()
+	}
+	if pc, _, _, ok := runtime.Caller(1); ok {
+		return runtime.FuncForPC(pc).Name(), nil
+	}
+	return "unknown", nil
+}
+
+// call of a wrapped function
+type call struct {
+	*wrapConfig
+	t     Telemetry
+	s     trace.Span
+	ctx   context.Context
+	name  string
+	start time.Time
+}
+
+// start the span of a call
+func (c *wrapConfig) start(t Telemetry, ctx context.Context, name string, attrs []attribute.KeyValue) (*call, context.Context) {
+	if c.spanName != "" {
+		name = c.spanName
+	}
+	x := &call{wrapConfig: c, t: t, name: name, start: time.Now()}
+	if c.kind == trace.SpanKindUnspecified && len(c.attrs) == 0 {
+		x.ctx, x.s = t.StartSpan(name, ctx, attrs...)
+		return x, x.ctx
+	}
+	opts := []trace.SpanStartOption{trace.WithAttributes(c.attrs...), trace.WithAttributes(attrs...)}
+	if c.kind != trace.SpanKindUnspecified {
+		opts = append(opts, trace.WithSpanKind(c.kind))
+	}
+	x.ctx, x.s = t.StartSpanWith(name, ctx, opts...)
+	return x, x.ctx
+}
+
+// results add attributes extracted by WithResult
+func (c *call) results(results []any, err error) {
+	c.s.SetAttributes(c.result(results, err)...)
+}
+
+// end the span of a call with the returned error or the recovered value, must be called in the deferred function
+func (c *call) end(err error, rec any) {
+	defer c.s.End()
+	outcome := "ok"
+	switch {
+	case rec != nil:
+		outcome = "panic"
+		c.t.RecordPanic(c.ctx, rec)
+	case err != nil && (c.failure == nil || c.failure(err)):
+		outcome = "error"
+		c.t.HandleError(err)
+		c.t.RecordError(c.ctx, err)
+	}
+	if !c.metrics {
+		return
+	}
+	name := c.name
+	if c.metric != "" {
+		name = c.metric
+	}
+	attrs := []attribute.KeyValue{attribute.String("span.name", name), attribute.String("outcome", outcome)}
+	c.t.Counter("function.calls", "{call}").Add(c.ctx, 1, attrs...)
+	if outcome != "ok" {
+		c.t.Counter("function.errors", "{error}").Add(c.ctx, 1, attrs...)
+	}
+	c.t.Histogram("function.duration", "s", durationBuckets...).Record(c.ctx, time.Since(c.start).Seconds(), attrs...)
+}
@@ -0,0 +1,149 @@
+package ote
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWrapMetrics(t *testing.T) {
+	rec, reader := setupTestTelemetry(t)
+	ctx := context.Background()
+	span := func() (string, []attribute.KeyValue) { return "job", nil }
+	fail := UseErr00(func(context.Context) error { return errors.New("failed") }, nil, span, WithMetrics())
+	_ = fail(ctx)
+	_ = UseErr10(func(_ context.Context, a int) error { return nil }, nil,
+		func(int) (string, []attribute.KeyValue) { return "job", nil }, WithMetrics())(ctx, 1)
+	func() {
+		defer func() { _ = recover() }()
+		Use00(func(context.Context) { panic("boom") }, nil, span, WithMetrics())(ctx)
+	}()
+	Use00(func(context.Context) {}, nil, span)(ctx)
+
+	if n := len(rec.Ended()); n != 4 {
+		t.Fatalf("spans %d", n)
+	}
+	ms := metricNames(t, reader)
+	outcomes := map[string]float64{}
+	for _, p := range ms["function.calls"].Data.(metricdata.Sum[float64]).DataPoints {
+		if v, _ := p.Attributes.Value("span.name"); v.AsString() != "job" {
+			t.Fatalf("span.name %v", p.Attributes)
+		}
+		v, _ := p.Attributes.Value("outcome")
+		outcomes[v.AsString()] = p.Value
+	}
+	if outcomes["ok"] != 1 || outcomes["error"] != 1 || outcomes["panic"] != 1 {
+		t.Fatalf("calls %v", outcomes)
+	}
+	if n := len(ms["function.errors"].Data.(metricdata.Sum[float64]).DataPoints); n != 2 {
+		t.Fatalf("errors %d", n)
+	}
+	var count uint64
+	for _, p := range ms["function.duration"].Data.(metricdata.Histogram[float64]).DataPoints {
+		count += p.Count
+	}
+	if count != 3 {
+		t.Fatalf("duration count %d", count)
+	}
+}
+
+func TestWrapRecordError(t *testing.T) {
+	rec, _ := setupTestTelemetry(t)
+	ctx := context.Background()
+	span := func() (string, []attribute.KeyValue) { return "job", nil }
+	_ = UseErr00(func(context.Context) error { return errors.New("failed") }, nil, span)(ctx)
+	_ = UseErr00(func(context.Context) error { panic("boom") }, nil, span)(ctx)
+	_ = UseErr00(func(context.Context) error { return nil }, nil, span)(ctx)
+
+	ended := rec.Ended()
+	failed, panicked, ok := ended[0], ended[1], ended[2]
+	if failed.Status().Code != codes.Error || failed.Status().Description != "failed" {
+		t.Fatalf("error status %v", failed.Status())
+	}
+	e := failed.Events()[0]
+	if e.Name != "exception" || attr(e.Attributes, "exception.message").AsString() != "failed" ||
+		attr(e.Attributes, "exception.stacktrace").AsString() == "" || attr(e.Attributes, "exception.escaped").AsBool() {
+		t.Fatalf("error event %v", e)
+	}
+	if panicked.Status().Code != codes.Error || panicked.Status().Description != "panic: boom" {
+		t.Fatalf("panic status %v", panicked.Status())
+	}
+	e = panicked.Events()[0]
+	switch {
+	case attr(e.Attributes, "exception.type").AsString() != "string":
+		t.Fatalf("panic type %v", e.Attributes)
+	case !attr(e.Attributes, "exception.escaped").AsBool():
+		t.Fatalf("panic escaped %v", e.Attributes)
+	case !strings.Contains(attr(e.Attributes, "exception.stacktrace").AsString(), "TestWrapRecordError"):
+		t.Fatalf("panic stack %v", e.Attributes)
+	}
+	if ok.Status().Code != codes.Unset || len(ok.Events()) != 0 {
+		t.Fatalf("ok %v %v", ok.Status(), ok.Events())
+	}
+}
+
+func TestWrapOptions(t *testing.T) {
+	rec, reader := setupTestTelemetry(t)
+	ctx := context.Background()
+	find := UseErr11(func(_ context.Context, id int) (string, error) {
+		if id == 0 {
+			return "", context.Canceled
+		}
+		return "alice", nil
+	}, nil,
+		func(id int) (string, []attribute.KeyValue) {
+			return "find", []attribute.KeyValue{attribute.Int("id", id)}
+		},
+		WithSpanKind(trace.SpanKindClient),
+		WithAttributes(attribute.String("db.system", "sqlite")),
+		WithExpectedErrors(context.Canceled),
+		WithResult(func(results []any, err error) []attribute.KeyValue {
+			return []attribute.KeyValue{attribute.Bool("found", err == nil && results[0] != "")}
+		}),
+		WithSpanName("user.find"),
+		WithMetrics())
+	if name, err := find(ctx, 1); name != "alice" || err != nil {
+		t.Fatal(name, err)
+	}
+	if _, err := find(ctx, 0); err != context.Canceled {
+		t.Fatal(err)
+	}
+	for i, sp := range rec.Ended() {
+		switch {
+		case sp.Name() != "user.find" || sp.SpanKind() != trace.SpanKindClient:
+			t.Fatalf("span %s %v", sp.Name(), sp.SpanKind())
+		case attr(sp.Attributes(), "db.system").AsString() != "sqlite" || attr(sp.Attributes(), "id").AsInt64() != int64(1-i):
+			t.Fatalf("attributes %v", sp.Attributes())
+		case attr(sp.Attributes(), "found").AsBool() != (i == 0):
+			t.Fatalf("result %v", sp.Attributes())
+		case sp.Status().Code != codes.Unset || len(sp.Events()) != 0:
+			t.Fatalf("expected error %v %v", sp.Status(), sp.Events())
+		}
+	}
+	if _, ok := metricNames(t, reader)["function.errors"]; ok {
+		t.Fatal("expected error counted")
+	}
+}
+
+func TestWrapMetricName(t *testing.T) {
+	rec, reader := setupTestTelemetry(t)
+	ctx := context.Background()
+	get := Use10(func(context.Context, int) {}, nil,
+		func(id int) (string, []attribute.KeyValue) { return "user." + strings.Repeat("x", id), nil },
+		WithMetrics(), WithMetricName("user.get"))
+	get(ctx, 1)
+	get(ctx, 2)
+	if e := rec.Ended(); len(e) != 2 || e[1].Name() != "user.xx" {
+		t.Fatalf("spans %v", e)
+	}
+	points := metricNames(t, reader)["function.calls"].Data.(metricdata.Sum[float64]).DataPoints
+	if v, _ := points[0].Attributes.Value("span.name"); len(points) != 1 || v.AsString() != "user.get" || points[0].Value != 2 {
+		t.Fatalf("calls %v", points)
+	}
+}